@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/spacemeshos/smcli/wallet"
+	"github.com/spacemeshos/smcli/wallet/history"
+)
+
+var (
+	// historyFromLayer restricts history to transactions at or after this layer.
+	historyFromLayer uint32
+
+	// historyJSON prints history as JSON instead of a table.
+	historyJSON bool
+)
+
+// historyCmd queries a node for an account's transaction history, to debug
+// stuck sends or reconstruct a wallet's activity from the chain.
+var historyCmd = &cobra.Command{
+	Use:   "history [wallet file] [node uri] [--from-layer N] [--account/-a selector] [--json]",
+	Short: "Show an account's transaction history from a node",
+	Long: `Queries a node for every account's transaction history (or a single account's,
+with --account), classifying each transaction as incoming, outgoing, or a self-spawn,
+and showing its layer, counterparty, amount, gas, and status. It also shows the
+account's current nonce and the number of transactions the node has accepted into
+the mempool but not yet applied, to help debug a send that appears stuck.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		walletFn, rest := popOptionalWalletArg(args, 1)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		nodeURI := rest[0]
+
+		w, err := openWallet(walletFn)
+		cobra.CheckErr(err)
+		defer w.Close()
+
+		accounts := w.Secrets.Accounts
+		if account != "" {
+			a, _, err := wallet.SelectAccount(accounts, account, hrp)
+			cobra.CheckErr(err)
+			accounts = []*wallet.Account{a}
+		}
+
+		nodeConn, err := grpc.NewClient(nodeURI, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		cobra.CheckErr(err)
+		defer nodeConn.Close()
+
+		histories, err := history.Fetch(context.Background(), nodeConn, accounts, hrp, historyFromLayer)
+		cobra.CheckErr(err)
+
+		if historyJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			cobra.CheckErr(enc.Encode(histories))
+			return
+		}
+
+		for _, h := range histories {
+			fmt.Printf("%s (nonce %d, %d pending)\n", h.Address, h.Nonce, h.Pending)
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"layer", "tx id", "direction", "counterparty", "amount", "gas", "status"})
+			for _, e := range h.Entries {
+				t.AppendRow(table.Row{
+					e.Layer,
+					e.TxID,
+					e.Direction,
+					e.Counterparty,
+					float64(e.Amount) / 1e9,
+					e.Gas,
+					e.Status,
+				})
+			}
+			t.Render()
+			fmt.Println()
+		}
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(historyCmd)
+	historyCmd.Flags().AddFlagSet(hrpFlagSet())
+	historyCmd.Flags().Uint32Var(&historyFromLayer, "from-layer", 0, "Only show transactions at or after this layer")
+	historyCmd.Flags().StringVarP(&account, "account", "a", "",
+		"Select an account by index, BIP-32 path suffix (e.g. \"0'/0'\"), or derived address")
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "Print history as JSON instead of a table")
+	historyCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+}
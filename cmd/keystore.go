@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/go-secure-stdlib/password"
+	"github.com/spf13/cobra"
+
+	"github.com/spacemeshos/smcli/internal/zero"
+	"github.com/spacemeshos/smcli/wallet"
+)
+
+var (
+	// keystoreOut is the file export-account writes the keystore JSON to.
+	keystoreOut string
+
+	// keystoreIn is the keystore JSON file import-account reads from.
+	keystoreIn string
+)
+
+// readPassphrase prompts for a passphrase with the given prompt text. The
+// caller is responsible for zeroing the returned bytes once done with them.
+func readPassphrase(prompt string) []byte {
+	fmt.Print(prompt)
+	pass, err := password.Read(os.Stdin)
+	fmt.Println()
+	cobra.CheckErr(err)
+	return []byte(pass)
+}
+
+// exportAccountCmd exports a single account as a portable, Ethereum-style
+// encrypted keystore JSON v3 file.
+var exportAccountCmd = &cobra.Command{
+	Use:   "export-account [wallet file] --account/-a selector --out acct.json",
+	Short: "Export a single account as an encrypted keystore JSON file",
+	Long: `Exports one account from a wallet as a keystore JSON v3 file, in the format
+popularized by go-ethereum: scrypt to derive a key from a passphrase, AES-128-CTR
+to encrypt the private key, and a keccak256 MAC to detect tampering or a wrong
+passphrase. The "curve" field is set to "ed25519" to disambiguate it from a real
+Ethereum (secp256k1) keystore. This passphrase is independent of the wallet's own
+password, and is asked for separately so the exported file can be handed to
+another person or tool without sharing the wallet password.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		walletFn, _ := popOptionalWalletArg(args, 0)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		if keystoreOut == "" {
+			cobra.CheckErr(fmt.Errorf("--out is required"))
+		}
+
+		w, err := openWallet(walletFn)
+		cobra.CheckErr(err)
+		defer w.Close()
+
+		a, _, err := wallet.SelectAccount(w.Secrets.Accounts, account, hrp)
+		cobra.CheckErr(err)
+
+		passphrase := readPassphrase("Enter a passphrase to encrypt the exported account: ")
+		defer zero.Bytes(passphrase)
+
+		data, err := wallet.ExportAccountKeystore(a, passphrase, hrp)
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(os.WriteFile(keystoreOut, data, 0o600))
+		fmt.Printf("Exported account to %s.\n", keystoreOut)
+	},
+}
+
+// importAccountCmd imports a single account from a keystore JSON v3 file
+// into an existing wallet.
+var importAccountCmd = &cobra.Command{
+	Use:   "import-account [wallet file] --in acct.json",
+	Short: "Import an account from an encrypted keystore JSON file into a wallet",
+	Long: `Decrypts a keystore JSON v3 file (as produced by export-account) and adds the
+account it contains to a wallet. If the keystore carries a BIP-32 derivation path,
+it's preserved; otherwise the account is labeled "imported".`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		walletFn, _ := popOptionalWalletArg(args, 0)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		if keystoreIn == "" {
+			cobra.CheckErr(fmt.Errorf("--in is required"))
+		}
+
+		data, err := os.ReadFile(keystoreIn)
+		cobra.CheckErr(err)
+
+		passphrase := readPassphrase("Enter the passphrase the keystore file was encrypted with: ")
+		defer zero.Bytes(passphrase)
+
+		imported, err := wallet.ImportAccountKeystore(data, passphrase)
+		cobra.CheckErr(err)
+
+		f, err := os.Open(walletFn)
+		cobra.CheckErr(err)
+
+		fmt.Print("Enter wallet password: ")
+		pass, err := password.Read(os.Stdin)
+		fmt.Println()
+		defer zero.String(&pass)
+		cobra.CheckErr(err)
+		passBytes := []byte(pass)
+		defer zero.Bytes(passBytes)
+
+		wk := wallet.NewKey(wallet.WithPasswordOnly(passBytes))
+		w, err := wk.Open(f, debug)
+		f.Close()
+		cobra.CheckErr(err)
+		defer w.Close()
+
+		w.Secrets.Accounts = append(w.Secrets.Accounts, imported)
+
+		out, err := os.OpenFile(walletFn, os.O_WRONLY|os.O_TRUNC, 0o600)
+		cobra.CheckErr(err)
+		defer out.Close()
+		cobra.CheckErr(wk.Export(out, w))
+
+		fmt.Printf("Imported account %s into %s.\n", imported.DisplayName, walletFn)
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(exportAccountCmd)
+	walletCmd.AddCommand(importAccountCmd)
+
+	exportAccountCmd.Flags().AddFlagSet(hrpFlagSet())
+	exportAccountCmd.Flags().StringVarP(&account, "account", "a", "", "Select an account by index, BIP-32 path suffix (e.g. \"0'/0'\"), or derived address")
+	exportAccountCmd.Flags().StringVar(&keystoreOut, "out", "", "File to write the encrypted keystore JSON to")
+	exportAccountCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+
+	importAccountCmd.Flags().StringVar(&keystoreIn, "in", "", "Keystore JSON file to import")
+	importAccountCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/spacemeshos/smcli/registry"
+)
+
+// listCmd lists the wallets registered with smcli.
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered wallets",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		reg, err := registry.Load()
+		cobra.CheckErr(err)
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.SetTitle("Registered Wallets")
+		t.AppendHeader(table.Row{"name", "path", "default"})
+
+		names := make([]string, 0, len(reg.Wallets))
+		for name := range reg.Wallets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			e := reg.Wallets[name]
+			isDefault := ""
+			if e.Name == reg.Default {
+				isDefault = "*"
+			}
+			t.AppendRow(table.Row{e.Name, e.Path, isDefault})
+		}
+		t.Render()
+	},
+}
+
+// renameCmd renames a registered wallet.
+var renameCmd = &cobra.Command{
+	Use:   "rename <old name> <new name>",
+	Short: "Rename a registered wallet",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		reg, err := registry.LoadForUpdate()
+		cobra.CheckErr(err)
+		cobra.CheckErr(reg.Rename(args[0], args[1]))
+		cobra.CheckErr(reg.Save())
+		fmt.Printf("Renamed wallet %q to %q.\n", args[0], args[1])
+	},
+}
+
+// defaultCmd sets the default wallet.
+var defaultCmd = &cobra.Command{
+	Use:   "default <name>",
+	Short: "Set the default wallet used when --name is omitted",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		reg, err := registry.LoadForUpdate()
+		cobra.CheckErr(err)
+		cobra.CheckErr(reg.SetDefault(args[0]))
+		cobra.CheckErr(reg.Save())
+		fmt.Printf("%s is now the default wallet.\n", args[0])
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(newCmd)
+	walletCmd.AddCommand(listCmd)
+	walletCmd.AddCommand(renameCmd)
+	walletCmd.AddCommand(defaultCmd)
+
+	createCmd.Flags().StringVar(&walletName, "name", "", "Name to register this wallet under")
+	newCmd.Flags().BoolVarP(&useLedger, "ledger", "l", false, "Create a wallet using a Ledger device")
+
+	readCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+	signCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+	balanceCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+	spawnCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+	sendCmd.Flags().StringVar(&walletName, "name", "", "Registered wallet to use instead of a file path")
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/spacemeshos/smcli/rpc/server"
+)
+
+var (
+	// listenAddr is the address the wallet daemon listens on.
+	listenAddr string
+
+	// serveNodeURI is the node the wallet daemon talks to on behalf of RPC callers.
+	serveNodeURI string
+
+	// serveToken is the shared secret clients must send in the
+	// "authorization" gRPC metadata key. If left empty, a random one is
+	// generated and printed on startup.
+	serveToken string
+)
+
+// serveCmd starts a long-lived wallet daemon.
+var serveCmd = &cobra.Command{
+	Use:   "serve [wallet file] --listen 127.0.0.1:PORT [--node-uri ...]",
+	Short: "Run a local wallet daemon that holds the decrypted wallet in memory on demand",
+	Long: `Starts a long-lived process that serves the WalletService gRPC API defined in
+rpc/proto/wallet.proto. The wallet file is not decrypted until a client calls Unlock,
+and the decrypted keys are zeroed automatically after the requested timeout (or
+immediately on an explicit Lock call).
+
+The daemon binds to --listen, which defaults to localhost, and requires every call to
+carry the daemon's token in its "authorization" gRPC metadata. Pass --token to set it
+explicitly (e.g. to share it with a client out of band); otherwise a random token is
+generated and printed once on startup.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		walletFn := args[0]
+
+		if serveToken == "" {
+			var tok [32]byte
+			if _, err := rand.Read(tok[:]); err != nil {
+				cobra.CheckErr(fmt.Errorf("generating auth token: %w", err))
+			}
+			serveToken = hex.EncodeToString(tok[:])
+			fmt.Printf("Auth token: %s (pass as the \"authorization\" gRPC metadata key)\n", serveToken)
+		}
+
+		srv := server.New(walletFn, serveNodeURI, hrp, serveToken)
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		fmt.Printf("Serving wallet %s on %s (node %s). Press Ctrl+C to stop.\n", walletFn, listenAddr, serveNodeURI)
+		cobra.CheckErr(ignoreContextCanceled(server.Serve(ctx, listenAddr, srv)))
+	},
+}
+
+// ignoreContextCanceled swallows the context.Canceled error Serve returns
+// on a clean shutdown, so it isn't reported as a command failure.
+func ignoreContextCanceled(err error) error {
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+func init() {
+	walletCmd.AddCommand(serveCmd)
+	hrpFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	hrpFlags.StringVar(&hrp, "hrp", types.NetworkHRP(), "Set human-readable address prefix")
+	serveCmd.Flags().AddFlagSet(hrpFlags)
+	serveCmd.Flags().StringVar(&listenAddr, "listen", "127.0.0.1:7099", "Address to listen on")
+	serveCmd.Flags().StringVar(&serveNodeURI, "node-uri", "", "URI of the Spacemesh node to relay balance/transaction calls to")
+	serveCmd.Flags().StringVar(&serveToken, "token", "", "Shared secret clients must send as the \"authorization\" gRPC metadata key (default: randomly generated and printed on startup)")
+}
@@ -1,14 +1,17 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"crypto"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -26,6 +29,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/spacemeshos/smcli/common"
+	"github.com/spacemeshos/smcli/internal/zero"
+	"github.com/spacemeshos/smcli/registry"
 	"github.com/spacemeshos/smcli/wallet"
 )
 
@@ -50,8 +55,45 @@ var (
 
 	// hrp is the human-readable network identifier used in Spacemesh network addresses.
 	hrp string
+
+	// walletName is the name of a registered wallet to operate on, resolved
+	// through the registry. If empty, the registry's default wallet is used.
+	walletName string
+
+	// account selects a single account within a wallet: an index, a
+	// BIP-32 derivation path suffix, or a derived address. Empty selects
+	// account 0.
+	account string
+
+	// signAll indicates that sign should sign the message with every
+	// account in the wallet instead of just the one selected by --account.
+	signAll bool
+
+	// fromFile is a CSV file of batch payments for send's --from-file mode.
+	fromFile string
 )
 
+// resolveWalletFile looks up the file path for walletName (or the default
+// wallet, if walletName is empty) in the wallet registry.
+func resolveWalletFile() string {
+	reg, err := registry.Load()
+	cobra.CheckErr(err)
+	path, err := reg.Resolve(walletName)
+	cobra.CheckErr(err)
+	return path
+}
+
+// popOptionalWalletArg splits a command's positional args into an optional
+// leading wallet-file path and the `need` arguments that follow it. If
+// exactly `need` args were given, the wallet file was omitted and must be
+// resolved via --name/the default wallet instead.
+func popOptionalWalletArg(args []string, need int) (walletFn string, rest []string) {
+	if len(args) == need+1 {
+		return args[0], args[1:]
+	}
+	return "", args
+}
+
 func openWallet(walletFn string) (*wallet.Wallet, error) {
 	// make sure the file exists
 	f, err := os.Open(walletFn)
@@ -60,14 +102,18 @@ func openWallet(walletFn string) (*wallet.Wallet, error) {
 
 	// get the password
 	fmt.Print("Enter wallet password: ")
-	password, err := password.Read(os.Stdin)
+	pass, err := password.Read(os.Stdin)
 	fmt.Println()
+	defer zero.String(&pass)
 	if err != nil {
 		return nil, err
 	}
 
+	passBytes := []byte(pass)
+	defer zero.Bytes(passBytes)
+
 	// attempt to read it
-	wk := wallet.NewKey(wallet.WithPasswordOnly([]byte(password)))
+	wk := wallet.NewKey(wallet.WithPasswordOnly(passBytes))
 	w, err := wk.Open(f, debug)
 	if err != nil {
 		return nil, err
@@ -84,93 +130,133 @@ var walletCmd = &cobra.Command{
 
 // createCmd represents the create command.
 var createCmd = &cobra.Command{
-	Use:   "create [--ledger] [numaccounts]",
+	Use:   "create [--ledger] [--name wallet-name] [numaccounts]",
 	Short: "Generate a new wallet file from a BIP-39-compatible mnemonic or Ledger device",
 	Long: `Create a new wallet file containing one or more accounts using a BIP-39-compatible mnemonic
 or a Ledger hardware wallet. If using a mnemonic you can choose to use an existing mnemonic or generate
 a new, random mnemonic.
 
 Add --ledger to instead read the public key from a Ledger device. If using a Ledger device please make
-sure the device is connected, unlocked, and the Spacemesh app is open.`,
+sure the device is connected, unlocked, and the Spacemesh app is open.
+
+The new wallet is registered under --name (or a name you're prompted for) so it can later be
+referred to with --name instead of its file path. The first wallet you create becomes the default.`,
 	Args: cobra.MaximumNArgs(1),
+	Run:  runCreate,
+}
+
+// newCmd is `wallet new <name>`, a thin alias for create that requires the
+// wallet's name up front instead of prompting for it.
+var newCmd = &cobra.Command{
+	Use:   "new <name> [--ledger] [numaccounts]",
+	Short: "Create a new named wallet (shorthand for create --name)",
+	Args:  cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
-		// get the number of accounts to create
-		n := 1
-		if len(args) > 0 {
-			tmpN, err := strconv.ParseInt(args[0], 10, 16)
-			cobra.CheckErr(err)
-			n = int(tmpN)
-		}
+		walletName = args[0]
+		runCreate(cmd, args[1:])
+	},
+}
 
-		var w *wallet.Wallet
-		var err error
+func runCreate(cmd *cobra.Command, args []string) {
+	// get the number of accounts to create
+	n := 1
+	if len(args) > 0 {
+		tmpN, err := strconv.ParseInt(args[0], 10, 16)
+		cobra.CheckErr(err)
+		n = int(tmpN)
+	}
 
-		// Short-circuit and check for a ledger device
-		if useLedger {
-			w, err = wallet.NewMultiWalletFromLedger(n)
-			cobra.CheckErr(err)
-			fmt.Println("Note that, when using a hardware wallet, the wallet file I'm about to produce won't " +
-				"contain any private keys or mnemonics, but you may still choose to encrypt it to protect privacy.")
-		} else {
-			// get or generate the mnemonic
-			fmt.Print("Enter a BIP-39-compatible mnemonic (or leave blank to generate a new one): ")
-			text, err := password.Read(os.Stdin)
-			fmt.Println()
-			cobra.CheckErr(err)
-			fmt.Print("Note: This application does not yet support BIP-39-compatible optional passwords. ")
-			fmt.Println("Support will be added soon.")
+	reg, err := registry.LoadForUpdate()
+	cobra.CheckErr(err)
 
-			// It's critical that we trim whitespace, including CRLF. Otherwise it will get included in the mnemonic.
-			text = strings.TrimSpace(text)
+	name := walletName
+	if name == "" {
+		fmt.Print("Enter a name for this wallet: ")
+		var rerr error
+		name, rerr = bufio.NewReader(os.Stdin).ReadString('\n')
+		cobra.CheckErr(rerr)
+		name = strings.TrimSpace(name)
+	}
+	cobra.CheckErr(registry.ValidateName(name))
+	if _, exists := reg.Wallets[name]; exists {
+		log.Fatalf("a wallet named %q already exists\n", name)
+	}
 
-			if text == "" {
-				w, err = wallet.NewMultiWalletRandomMnemonic(n)
-				cobra.CheckErr(err)
-				fmt.Print("\nThis is your mnemonic (seed phrase). Write it down and store it safely.")
-				fmt.Print("It is the ONLY way to restore your wallet.\n")
-				fmt.Print("Neither Spacemesh nor anyone else can help you restore your wallet without this mnemonic.\n")
-				fmt.Print("\n***********************************\n")
-				fmt.Print("SAVE THIS MNEMONIC IN A SAFE PLACE!")
-				fmt.Print("\n***********************************\n")
-				fmt.Println()
-				fmt.Println(w.Mnemonic())
-				fmt.Println("\nPress enter when you have securely saved your mnemonic.")
-				_, _ = fmt.Scanln()
-			} else {
-				// try to use as a mnemonic
-				w, err = wallet.NewMultiWalletFromMnemonic(text, n)
-				cobra.CheckErr(err)
-			}
-		}
+	var w *wallet.Wallet
 
-		fmt.Print("Enter a secure password used to encrypt the wallet file (optional but strongly recommended): ")
-		password, err := password.Read(os.Stdin)
-		fmt.Println()
+	// Short-circuit and check for a ledger device
+	if useLedger {
+		w, err = wallet.NewMultiWalletFromLedger(n)
 		cobra.CheckErr(err)
-		wk := wallet.NewKey(wallet.WithRandomSalt(), wallet.WithPbkdf2Password([]byte(password)))
-		err = os.MkdirAll(common.DotDirectory(), 0o700)
+		fmt.Println("Note that, when using a hardware wallet, the wallet file I'm about to produce won't " +
+			"contain any private keys or mnemonics, but you may still choose to encrypt it to protect privacy.")
+	} else {
+		// get or generate the mnemonic
+		fmt.Print("Enter a BIP-39-compatible mnemonic (or leave blank to generate a new one): ")
+		text, err := password.Read(os.Stdin)
+		fmt.Println()
+		defer zero.String(&text)
 		cobra.CheckErr(err)
+		fmt.Print("Note: This application does not yet support BIP-39-compatible optional passwords. ")
+		fmt.Println("Support will be added soon.")
+
+		// It's critical that we trim whitespace, including CRLF. Otherwise it will get included in the mnemonic.
+		text = strings.TrimSpace(text)
 
-		// Make sure we're not overwriting an existing wallet (this should not happen)
-		walletFn := common.WalletFile()
-		_, err = os.Stat(walletFn)
-		switch {
-		case errors.Is(err, os.ErrNotExist):
-			// all fine
-		case err == nil:
-			log.Fatalln("Wallet file already exists")
-		default:
-			log.Fatalf("Error opening %s: %v\n", walletFn, err)
+		if text == "" {
+			w, err = wallet.NewMultiWalletRandomMnemonic(n)
+			cobra.CheckErr(err)
+			fmt.Print("\nThis is your mnemonic (seed phrase). Write it down and store it safely.")
+			fmt.Print("It is the ONLY way to restore your wallet.\n")
+			fmt.Print("Neither Spacemesh nor anyone else can help you restore your wallet without this mnemonic.\n")
+			fmt.Print("\n***********************************\n")
+			fmt.Print("SAVE THIS MNEMONIC IN A SAFE PLACE!")
+			fmt.Print("\n***********************************\n")
+			fmt.Println()
+			fmt.Println(w.Mnemonic())
+			fmt.Println("\nPress enter when you have securely saved your mnemonic.")
+			_, _ = fmt.Scanln()
+		} else {
+			// try to use as a mnemonic
+			w, err = wallet.NewMultiWalletFromMnemonic(text, n)
+			cobra.CheckErr(err)
 		}
+	}
+	defer w.Close()
 
-		// Now open for writing
-		f2, err := os.OpenFile(walletFn, os.O_WRONLY|os.O_CREATE, 0o600)
-		cobra.CheckErr(err)
-		defer f2.Close()
-		cobra.CheckErr(wk.Export(f2, w))
+	fmt.Print("Enter a secure password used to encrypt the wallet file (optional but strongly recommended): ")
+	pass, err := password.Read(os.Stdin)
+	fmt.Println()
+	defer zero.String(&pass)
+	cobra.CheckErr(err)
+	passBytes := []byte(pass)
+	defer zero.Bytes(passBytes)
+	wk := wallet.NewKey(wallet.WithRandomSalt(), wallet.WithPbkdf2Password(passBytes))
+	err = os.MkdirAll(common.DotDirectory(), 0o700)
+	cobra.CheckErr(err)
 
-		fmt.Printf("Wallet saved to %s. BACK UP THIS FILE NOW!\n", walletFn)
-	},
+	// Make sure we're not overwriting an existing wallet (this should not happen)
+	walletFn := filepath.Join(common.DotDirectory(), name+".json")
+	_, err = os.Stat(walletFn)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// all fine
+	case err == nil:
+		log.Fatalln("Wallet file already exists")
+	default:
+		log.Fatalf("Error opening %s: %v\n", walletFn, err)
+	}
+
+	// Now open for writing
+	f2, err := os.OpenFile(walletFn, os.O_WRONLY|os.O_CREATE, 0o600)
+	cobra.CheckErr(err)
+	defer f2.Close()
+	cobra.CheckErr(wk.Export(f2, w))
+
+	cobra.CheckErr(reg.Add(name, walletFn))
+	cobra.CheckErr(reg.Save())
+
+	fmt.Printf("Wallet saved to %s. BACK UP THIS FILE NOW!\n", walletFn)
 }
 
 // readCmd reads an existing wallet file.
@@ -182,13 +268,18 @@ successfully read and decrypted, whether the password to open the file is correc
 It prints the accounts from the wallet file. By default it does not print private keys.
 Add --private to print private keys. Add --full to print full keys. Add --base58 to print
 keys in base58 format rather than hexadecimal. Add --parent to print parent key (and not
-only child keys).`,
-	Args: cobra.ExactArgs(1),
+only child keys). The wallet file may be given as a path, or omitted in favor of --name
+(or the default wallet, if --name is also omitted).`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		walletFn := args[0]
+		walletFn, _ := popOptionalWalletArg(args, 0)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
 
 		w, err := openWallet(walletFn)
 		cobra.CheckErr(err)
+		defer w.Close()
 
 		widthEnforcer := func(col string, maxLen int) string {
 			if len(col) <= maxLen {
@@ -308,52 +399,87 @@ only child keys).`,
 	},
 }
 
+// signedMessage is the JSON format compatible with smapp's signing feature.
+type signedMessage struct {
+	Text      string `json:"text"`
+	Signature string `json:"signature"`
+	PublicKey string `json:"publicKey"`
+}
+
+func signWith(a *wallet.Account, message string) signedMessage {
+	sk := ed25519.PrivateKey(a.Private)
+	sig, err := sk.Sign(nil, []byte(message), crypto.Hash(0))
+	cobra.CheckErr(err)
+	return signedMessage{
+		Text:      message,
+		Signature: "0x" + hex.EncodeToString(sig),
+		PublicKey: "0x" + hex.EncodeToString(a.Public),
+	}
+}
+
 var signCmd = &cobra.Command{
-	Use:   "sign [wallet file] [message]",
-	Short: "Signs a message using a wallet's first child key",
-	Args:  cobra.ExactArgs(2),
+	Use:   "sign [wallet file] [message] [--account/-a selector] [--all]",
+	Short: "Signs a message with a wallet account",
+	Long: `Signs a message with a single wallet account, selected with --account (by
+index, BIP-32 path suffix, or derived address) or defaulting to account 0.
+With --all, signs the message with every account in the wallet and prints a
+JSON array instead of a single object.`,
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
-		walletFn := args[0]
-		message := args[1]
+		walletFn, rest := popOptionalWalletArg(args, 1)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		message := rest[0]
 
 		w, err := openWallet(walletFn)
 		cobra.CheckErr(err)
+		defer w.Close()
 
-		// Sign message using child account 0.
-		child0 := w.Secrets.Accounts[0] // TODO: flag to select child
-		sk0 := ed25519.PrivateKey(child0.Private)
-		sig, err := sk0.Sign(nil, []byte(message), crypto.Hash(0))
-		cobra.CheckErr(err)
-
-		// Output signed message in a JSON format compatible with smapp's signing feature.
-		type signedMessage struct {
-			Text      string `json:"text"`
-			Signature string `json:"signature"`
-			PublicKey string `json:"publicKey"`
-		}
-		out := signedMessage{
-			Text:      message,
-			Signature: "0x" + hex.EncodeToString(sig),
-			PublicKey: "0x" + hex.EncodeToString(child0.Public),
-		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		enc.Encode(out)
+
+		if signAll {
+			out := make([]signedMessage, len(w.Secrets.Accounts))
+			for i, a := range w.Secrets.Accounts {
+				out[i] = signWith(a, message)
+			}
+			cobra.CheckErr(enc.Encode(out))
+			return
+		}
+
+		a, _, err := wallet.SelectAccount(w.Secrets.Accounts, account, hrp)
+		cobra.CheckErr(err)
+		cobra.CheckErr(enc.Encode(signWith(a, message)))
 	},
 }
 
 var balanceCmd = &cobra.Command{
-	Use:   "balance [wallet file] [node uri]",
+	Use:   "balance [wallet file] [node uri] [--account/-a selector]",
 	Short: "Retrieve balance",
-	Args:  cobra.ExactArgs(2),
+	Long: `Retrieves the on-chain balance of a wallet's accounts. By default it shows
+every account; pass --account to show only the account matched by index, BIP-32
+path suffix, or derived address.`,
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
-		walletFn := args[0]
-		nodeURI := args[1]
+		walletFn, rest := popOptionalWalletArg(args, 1)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		nodeURI := rest[0]
 
 		types.SetNetworkHRP(hrp)
 
 		w, err := openWallet(walletFn)
 		cobra.CheckErr(err)
+		defer w.Close()
+
+		accounts := w.Secrets.Accounts
+		if account != "" {
+			a, _, err := wallet.SelectAccount(accounts, account, hrp)
+			cobra.CheckErr(err)
+			accounts = []*wallet.Account{a}
+		}
 
 		ctx := context.Background()
 
@@ -373,16 +499,16 @@ var balanceCmd = &cobra.Command{
 			"name",
 			"balance",
 		})
-		for idx, account := range w.Secrets.Accounts {
-			address := wallet.PubkeyToAddress(account.Public, hrp)
+		for idx, acc := range accounts {
+			address := wallet.PubkeyToAddress(acc.Public, hrp)
 			accountReq := pb.AccountRequest{AccountId: &pb.AccountId{Address: string(address)}}
 			accountResp, err := globalStateClient.Account(ctx, &accountReq)
 			cobra.CheckErr(err)
 			t.AppendRow(table.Row{
 				idx,
-				wallet.PubkeyToAddress(account.Public, hrp),
-				account.Path.String(),
-				account.DisplayName,
+				address,
+				acc.Path.String(),
+				acc.DisplayName,
 				float64(accountResp.AccountWrapper.StateProjected.Balance.Value) / 1e9,
 			})
 		}
@@ -391,19 +517,24 @@ var balanceCmd = &cobra.Command{
 }
 
 var spawnCmd = &cobra.Command{
-	Use:   "spawn [wallet file] [node uri]",
+	Use:   "spawn [wallet file] [node uri] [--account/-a selector]",
 	Short: "Spawn wallet",
-	Args:  cobra.ExactArgs(2),
+	Args:  cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
-		walletFn := args[0]
-		nodeURI := args[1]
+		walletFn, rest := popOptionalWalletArg(args, 1)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		nodeURI := rest[0]
 
 		types.SetNetworkHRP(hrp)
 
 		w, err := openWallet(walletFn)
 		cobra.CheckErr(err)
+		defer w.Close()
 
-		senderAccount := w.Secrets.Accounts[0] // TODO: flag to select child
+		senderAccount, _, err := wallet.SelectAccount(w.Secrets.Accounts, account, hrp)
+		cobra.CheckErr(err)
 
 		ctx := context.Background()
 
@@ -439,34 +570,119 @@ var spawnCmd = &cobra.Command{
 	},
 }
 
+// nonceTracker fetches each sender account's projected counter from the
+// node once, uses that + 1 as its first nonce, then hands out
+// locally-incrementing nonces for subsequent payments
+// from the same account within a single batch, so a CSV of payments from
+// the same account doesn't need a round trip per row.
+type nonceTracker struct {
+	ctx    context.Context
+	client pb.GlobalStateServiceClient
+	hrp    string
+	next   map[int]uint64 // account index -> next nonce to use
+}
+
+func newNonceTracker(ctx context.Context, client pb.GlobalStateServiceClient, hrp string) *nonceTracker {
+	return &nonceTracker{ctx: ctx, client: client, hrp: hrp, next: map[int]uint64{}}
+}
+
+func (nt *nonceTracker) nonceFor(idx int, acc *wallet.Account) (uint64, error) {
+	if n, ok := nt.next[idx]; ok {
+		nt.next[idx] = n + 1
+		return n, nil
+	}
+	address := wallet.PubkeyToAddress(acc.Public, nt.hrp)
+	resp, err := nt.client.Account(nt.ctx, &pb.AccountRequest{AccountId: &pb.AccountId{Address: string(address)}})
+	if err != nil {
+		return 0, fmt.Errorf("querying nonce for %s: %w", address, err)
+	}
+	n := resp.AccountWrapper.StateProjected.Counter + 1
+	nt.next[idx] = n + 1
+	return n, nil
+}
+
+// submitSpend signs and submits a single spend transaction.
+func submitSpend(
+	ctx context.Context, txClient pb.TransactionServiceClient, genesisID types.Hash20,
+	sender *wallet.Account, recipient types.Address, amount, nonce uint64,
+) (*pb.SubmitTransactionResponse, error) {
+	tx := walletSdk.Spend(
+		ed25519.PrivateKey(sender.Private),
+		recipient,
+		amount,
+		nonce,
+		sdk.WithGenesisID(genesisID),
+	)
+	return txClient.SubmitTransaction(ctx, &pb.SubmitTransactionRequest{Transaction: tx})
+}
+
+// payment is a single row of a --from-file batch payments CSV: account,recipient,amount.
+type payment struct {
+	account   string
+	recipient string
+	amount    string
+}
+
+// readPayments reads a "account,recipient,amount" CSV file of batch payments.
+func readPayments(fn string) ([]payment, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", fn, err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 3
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", fn, err)
+	}
+
+	payments := make([]payment, len(records))
+	for i, rec := range records {
+		payments[i] = payment{account: rec[0], recipient: rec[1], amount: rec[2]}
+	}
+	return payments, nil
+}
+
 var sendCmd = &cobra.Command{
-	Use:   "send [wallet file] [node uri] [recipient address] [smh amount]",
+	Use:   "send [wallet file] [node uri] [recipient address] [smh amount] [--account/-a selector]",
 	Short: "Send transaction",
-	Args:  cobra.ExactArgs(4),
+	Long: `Sends a spend transaction from a single account, selected with --account (by
+index, BIP-32 path suffix, or derived address) or defaulting to account 0.
+
+With --from-file, the recipient and amount arguments are omitted; instead,
+every row of the given CSV file (columns: account,recipient,amount) is sent
+as its own transaction. Multiple rows paying from the same account use a
+locally-incremented nonce, so a whole batch can be submitted without waiting
+for each transaction to land on chain first.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if fromFile != "" {
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		}
+		return cobra.RangeArgs(3, 4)(cmd, args)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		walletFn := args[0]
-		nodeURI := args[1]
-		recipientAddressString := args[2]
-		smhAmountString := args[3]
+		need := 3
+		if fromFile != "" {
+			need = 1
+		}
+		walletFn, rest := popOptionalWalletArg(args, need)
+		if walletFn == "" {
+			walletFn = resolveWalletFile()
+		}
+		nodeURI := rest[0]
 
 		types.SetNetworkHRP(hrp)
 
 		w, err := openWallet(walletFn)
 		cobra.CheckErr(err)
+		defer w.Close()
 
 		nodeConn, err := grpc.NewClient(nodeURI, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		cobra.CheckErr(err)
 		defer nodeConn.Close()
 
-		recipientAddress, err := types.StringToAddress(recipientAddressString)
-		cobra.CheckErr(err)
-
-		smhAmount, err := strconv.ParseFloat(smhAmountString, 64) // TODO: use decimal
-		cobra.CheckErr(err)
-
-		senderAccount := w.Secrets.Accounts[0] // TODO: flag to select child
-		senderAddress := wallet.PubkeyToAddress(senderAccount.Public, hrp)
-
 		ctx := context.Background()
 
 		meshClient := pb.NewMeshServiceClient(nodeConn)
@@ -475,24 +691,46 @@ var sendCmd = &cobra.Command{
 		genesisID := types.Hash20(meshResp.GenesisId)
 
 		globalStateClient := pb.NewGlobalStateServiceClient(nodeConn)
-		accountReq := pb.AccountRequest{AccountId: &pb.AccountId{Address: string(senderAddress)}}
-		accountResp, err := globalStateClient.Account(ctx, &accountReq)
-		cobra.CheckErr(err)
-		nonce := accountResp.AccountWrapper.StateProjected.Counter
+		txClient := pb.NewTransactionServiceClient(nodeConn)
+		nonces := newNonceTracker(ctx, globalStateClient, hrp)
 
-		tx := walletSdk.Spend(
-			ed25519.PrivateKey(senderAccount.Private),
-			recipientAddress,
-			uint64(smhAmount*1e9), // TODO: use decimal
-			nonce+1,
-			sdk.WithGenesisID(genesisID),
-		)
+		if fromFile != "" {
+			payments, err := readPayments(fromFile)
+			cobra.CheckErr(err)
 
-		txClient := pb.NewTransactionServiceClient(nodeConn)
-		// txResp, _ := txClient.ParseTransaction(ctx, &api.ParseTransactionRequest{Transaction: tx})
-		// cobra.CheckErr(err)
+			for i, p := range payments {
+				sender, idx, err := wallet.SelectAccount(w.Secrets.Accounts, p.account, hrp)
+				cobra.CheckErr(err)
+				recipient, err := types.StringToAddress(p.recipient)
+				cobra.CheckErr(err)
+				smhAmount, err := strconv.ParseFloat(p.amount, 64) // TODO: use decimal
+				cobra.CheckErr(err)
+				nonce, err := nonces.nonceFor(idx, sender)
+				cobra.CheckErr(err)
 
-		sendResp, err := txClient.SubmitTransaction(ctx, &pb.SubmitTransactionRequest{Transaction: tx})
+				sendResp, err := submitSpend(ctx, txClient, genesisID, sender, recipient, uint64(smhAmount*1e9), nonce)
+				cobra.CheckErr(err)
+				fmt.Printf("[%d/%d] sent %s -> %s: id=%s status=%d state=%s\n",
+					i+1, len(payments), p.account, p.recipient,
+					hex.EncodeToString(sendResp.Txstate.Id.Id),
+					sendResp.Status.Code,
+					sendResp.Txstate.State.String(),
+				)
+			}
+			return
+		}
+
+		recipientAddress, err := types.StringToAddress(rest[1])
+		cobra.CheckErr(err)
+		smhAmount, err := strconv.ParseFloat(rest[2], 64) // TODO: use decimal
+		cobra.CheckErr(err)
+
+		sender, idx, err := wallet.SelectAccount(w.Secrets.Accounts, account, hrp)
+		cobra.CheckErr(err)
+		nonce, err := nonces.nonceFor(idx, sender)
+		cobra.CheckErr(err)
+
+		sendResp, err := submitSpend(ctx, txClient, genesisID, sender, recipientAddress, uint64(smhAmount*1e9), nonce)
 		cobra.CheckErr(err)
 
 		fmt.Printf("Submitted spend transaction! id=%s status=%d state=%s\n",
@@ -503,6 +741,14 @@ var sendCmd = &cobra.Command{
 	},
 }
 
+// hrpFlagSet returns a fresh flag set containing the shared --hrp flag, for
+// commands that talk to the network and need to render addresses.
+func hrpFlagSet() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	fs.StringVar(&hrp, "hrp", types.NetworkHRP(), "Set human-readable address prefix")
+	return fs
+}
+
 func init() {
 	rootCmd.AddCommand(walletCmd)
 	walletCmd.AddCommand(createCmd)
@@ -511,8 +757,7 @@ func init() {
 	walletCmd.AddCommand(balanceCmd)
 	walletCmd.AddCommand(spawnCmd)
 	walletCmd.AddCommand(sendCmd)
-	hrpFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
-	hrpFlags.StringVar(&hrp, "hrp", types.NetworkHRP(), "Set human-readable address prefix")
+	hrpFlags := hrpFlagSet()
 	readCmd.Flags().BoolVarP(&printPrivate, "private", "p", false, "Print private keys")
 	readCmd.Flags().BoolVarP(&printFull, "full", "f", false, "Print full keys (no abbreviation)")
 	readCmd.Flags().BoolVar(&printBase58, "base58", false, "Print keys in base58 (rather than hex)")
@@ -523,4 +768,13 @@ func init() {
 	balanceCmd.Flags().AddFlagSet(hrpFlags)
 	spawnCmd.Flags().AddFlagSet(hrpFlags)
 	sendCmd.Flags().AddFlagSet(hrpFlags)
+
+	const accountFlagHelp = "Select an account by index, BIP-32 path suffix (e.g. \"0'/0'\"), or derived address"
+	signCmd.Flags().AddFlagSet(hrpFlags)
+	signCmd.Flags().StringVarP(&account, "account", "a", "", accountFlagHelp)
+	signCmd.Flags().BoolVar(&signAll, "all", false, "Sign the message with every account, printing a JSON array")
+	balanceCmd.Flags().StringVarP(&account, "account", "a", "", accountFlagHelp)
+	spawnCmd.Flags().StringVarP(&account, "account", "a", "", accountFlagHelp)
+	sendCmd.Flags().StringVarP(&account, "account", "a", "", accountFlagHelp)
+	sendCmd.Flags().StringVar(&fromFile, "from-file", "", "Batch-send payments read from a CSV file (columns: account,recipient,amount)")
 }
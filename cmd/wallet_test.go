@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
+	"google.golang.org/grpc"
+
+	"github.com/spacemeshos/smcli/wallet"
+)
+
+func TestReadPayments(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "payments.csv")
+	csv := "0,sm1recipientone,1.5\n1,sm1recipienttwo,2.25\n"
+	if err := os.WriteFile(fn, []byte(csv), 0o600); err != nil {
+		t.Fatalf("writing CSV: %v", err)
+	}
+
+	payments, err := readPayments(fn)
+	if err != nil {
+		t.Fatalf("readPayments: %v", err)
+	}
+	want := []payment{
+		{account: "0", recipient: "sm1recipientone", amount: "1.5"},
+		{account: "1", recipient: "sm1recipienttwo", amount: "2.25"},
+	}
+	if len(payments) != len(want) {
+		t.Fatalf("got %d payments, want %d", len(payments), len(want))
+	}
+	for i := range want {
+		if payments[i] != want[i] {
+			t.Errorf("payment %d = %+v, want %+v", i, payments[i], want[i])
+		}
+	}
+}
+
+func TestReadPaymentsWrongColumnCount(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "payments.csv")
+	if err := os.WriteFile(fn, []byte("0,sm1recipientone\n"), 0o600); err != nil {
+		t.Fatalf("writing CSV: %v", err)
+	}
+	if _, err := readPayments(fn); err == nil {
+		t.Fatalf("readPayments with a missing column should have failed")
+	}
+}
+
+// fakeGlobalStateClient answers Account with a fixed projected counter per
+// address and panics on any other method, since nonceTracker only calls
+// Account.
+type fakeGlobalStateClient struct {
+	pb.GlobalStateServiceClient
+	projected map[string]uint64
+}
+
+func (f *fakeGlobalStateClient) Account(
+	_ context.Context, in *pb.AccountRequest, _ ...grpc.CallOption,
+) (*pb.AccountResponse, error) {
+	return &pb.AccountResponse{
+		AccountWrapper: &pb.Account{
+			StateProjected: &pb.AccountState{Counter: f.projected[in.AccountId.Address]},
+		},
+	}, nil
+}
+
+func TestNonceTrackerQueriesOnceThenIncrementsLocally(t *testing.T) {
+	acc := &wallet.Account{Public: make([]byte, 32)}
+	address := string(wallet.PubkeyToAddress(acc.Public, "sm"))
+
+	client := &fakeGlobalStateClient{projected: map[string]uint64{address: 4}}
+	nt := newNonceTracker(context.Background(), client, "sm")
+
+	for i, want := range []uint64{5, 6, 7} {
+		got, err := nt.nonceFor(0, acc)
+		if err != nil {
+			t.Fatalf("nonceFor #%d: %v", i, err)
+		}
+		if got != want {
+			t.Fatalf("nonceFor #%d = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestNonceTrackerPerAccount(t *testing.T) {
+	accA := &wallet.Account{Public: make([]byte, 32)}
+	accB := &wallet.Account{Public: append(make([]byte, 31), 1)}
+	addrA := string(wallet.PubkeyToAddress(accA.Public, "sm"))
+	addrB := string(wallet.PubkeyToAddress(accB.Public, "sm"))
+
+	client := &fakeGlobalStateClient{projected: map[string]uint64{addrA: 0, addrB: 10}}
+	nt := newNonceTracker(context.Background(), client, "sm")
+
+	if got, err := nt.nonceFor(0, accA); err != nil || got != 1 {
+		t.Fatalf("nonceFor(0, accA) = %d, %v, want 1, nil", got, err)
+	}
+	if got, err := nt.nonceFor(1, accB); err != nil || got != 11 {
+		t.Fatalf("nonceFor(1, accB) = %d, %v, want 11, nil", got, err)
+	}
+	if got, err := nt.nonceFor(0, accA); err != nil || got != 2 {
+		t.Fatalf("second nonceFor(0, accA) = %d, %v, want 2, nil", got, err)
+	}
+}
@@ -0,0 +1,33 @@
+// Package common holds small helpers shared across smcli's cmd and wallet packages.
+package common
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// dotDirName is the name of the directory (under the user's home directory)
+// where smcli stores its wallet file and other local state.
+const dotDirName = ".smcli"
+
+// walletFileName is the name of the legacy single wallet file within
+// DotDirectory().
+const walletFileName = "my_wallet.json"
+
+// DotDirectory returns the path to smcli's local state directory, creating
+// no directories itself -- callers are expected to os.MkdirAll it.
+func DotDirectory() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		// fall back to the current directory if we can't determine $HOME
+		home = "."
+	}
+	return filepath.Join(home, dotDirName)
+}
+
+// WalletFile returns the path to the legacy single wallet file, which the
+// wallet registry imports on first run. The default wallet itself is
+// resolved through the registry.
+func WalletFile() string {
+	return filepath.Join(DotDirectory(), walletFileName)
+}
@@ -0,0 +1,28 @@
+// Package zero provides best-effort helpers for wiping sensitive byte
+// slices from memory once they're no longer needed, following the pattern
+// used by btcwallet/dcrwallet's internal zero package.
+package zero
+
+// Bytes overwrites every byte of b with zero. It is a no-op for a nil or
+// empty slice. Callers should defer this immediately after allocating or
+// receiving a slice containing sensitive material (passwords, mnemonics,
+// private keys, derived keys).
+func Bytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// String drops s's reference to its backing array so the string becomes
+// eligible for garbage collection. A Go string's backing array may live in
+// read-only memory (string literals, interned constants), so unlike Bytes
+// it can't safely be overwritten in place — writing to it can segfault the
+// process. This only hastens GC reclamation, it doesn't guarantee the bytes
+// are gone the moment it returns. Callers that need a hard guarantee should
+// store secret text as []byte from the start and use Bytes instead.
+func String(s *string) {
+	if s == nil {
+		return
+	}
+	*s = ""
+}
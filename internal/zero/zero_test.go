@@ -0,0 +1,37 @@
+package zero
+
+import "testing"
+
+func TestBytes(t *testing.T) {
+	b := []byte("super secret")
+	Bytes(b)
+	for i, c := range b {
+		if c != 0 {
+			t.Fatalf("b[%d] = %d, want 0", i, c)
+		}
+	}
+}
+
+func TestBytesNilAndEmpty(t *testing.T) {
+	Bytes(nil)
+	Bytes([]byte{})
+}
+
+func TestString(t *testing.T) {
+	s := "super secret"
+
+	String(&s)
+
+	if s != "" {
+		t.Fatalf("s = %q, want empty", s)
+	}
+}
+
+func TestStringNilAndEmpty(t *testing.T) {
+	String(nil)
+	s := ""
+	String(&s)
+	if s != "" {
+		t.Fatalf("s = %q, want empty", s)
+	}
+}
@@ -0,0 +1,285 @@
+// Package registry tracks the set of named wallets smcli knows about,
+// stored at ~/.smcli/wallets.json, and which one is the default. It lets
+// users refer to a wallet by name instead of always typing out its file
+// path.
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spacemeshos/smcli/common"
+)
+
+// fileName is the name of the registry file within common.DotDirectory().
+const fileName = "wallets.json"
+
+// lockFileName is the name of the file LoadForUpdate locks to serialize a
+// LoadForUpdate-mutate-Save cycle against concurrent smcli invocations
+// (and that Load locks only while it runs a first-run migration).
+const lockFileName = fileName + ".lock"
+
+// Entry is a single named wallet.
+type Entry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Registry is the full set of named wallets smcli knows about.
+type Registry struct {
+	Default string           `json:"default"`
+	Wallets map[string]Entry `json:"wallets"`
+
+	// lock is held from LoadForUpdate until Save or Close, so that a
+	// concurrent LoadForUpdate-mutate-Save cycle in another smcli invocation
+	// (e.g. two `wallet new` calls) can't interleave with this one and lose
+	// an update. It is nil for a Registry obtained via Load.
+	lock *os.File
+}
+
+// File returns the path to the registry file.
+func File() string {
+	return filepath.Join(common.DotDirectory(), fileName)
+}
+
+// Load reads the registry file, creating and populating it via migration
+// from the legacy single-wallet layout if it doesn't exist yet. The
+// returned Registry holds no lock; use Load for read-only access (e.g.
+// `wallet list`, resolving --name). Callers that mean to mutate the
+// registry and Save it back must use LoadForUpdate instead, or they risk
+// losing a concurrent update.
+//
+// A first-run migration is itself a write (it creates wallets.json), so
+// Load still takes the registry lock for the duration of the migration:
+// otherwise two concurrent Loads against a fresh ~/.smcli could both
+// migrate and Save, and the second would silently clobber the first's
+// result. The lock is released before Load returns.
+func Load() (*Registry, error) {
+	return load(nil)
+}
+
+// LoadForUpdate is like Load, but also acquires an exclusive, blocking lock
+// that's held until Save releases it (or Close, if the registry ends up not
+// being saved), so that a concurrent LoadForUpdate-mutate-Save cycle in
+// another smcli invocation can't interleave with this one and lose an
+// update. Callers must call Save or Close exactly once on the result.
+func LoadForUpdate() (*Registry, error) {
+	lock, err := lockRegistry()
+	if err != nil {
+		return nil, err
+	}
+	r, err := load(lock)
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func load(lock *os.File) (*Registry, error) {
+	f, err := os.Open(File())
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if lock == nil {
+			// Plain Load: take the registry lock ourselves just to
+			// serialize the migration below against a concurrent Load
+			// doing the same thing, then release it again before
+			// returning, since Load doesn't hold the lock past this
+			// call.
+			l, err := lockRegistry()
+			if err != nil {
+				return nil, err
+			}
+			defer l.Close()
+			r, err := load(l)
+			if err != nil {
+				return nil, err
+			}
+			r.lock = nil
+			return r, nil
+		}
+		r, err := migrate()
+		if err != nil {
+			return nil, err
+		}
+		r.lock = lock
+		return r, nil
+	case err != nil:
+		return nil, fmt.Errorf("opening registry: %w", err)
+	}
+	defer f.Close()
+
+	var r Registry
+	if err := json.NewDecoder(f).Decode(&r); err != nil {
+		return nil, fmt.Errorf("parsing registry: %w", err)
+	}
+	if r.Wallets == nil {
+		r.Wallets = map[string]Entry{}
+	}
+	r.lock = lock
+	return &r, nil
+}
+
+// lockRegistry acquires an exclusive, blocking file lock on the registry's
+// lock file in common.DotDirectory().
+func lockRegistry() (*os.File, error) {
+	dir := common.DotDirectory()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating %s: %w", dir, err)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, lockFileName), os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening registry lock file: %w", err)
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("locking registry: %w", err)
+	}
+	return f, nil
+}
+
+// Close releases the lock LoadForUpdate acquired, if any, without saving.
+// It's a no-op for a Registry obtained via Load, or one whose lock has
+// already been released by a prior Save.
+func (r *Registry) Close() error {
+	if r.lock == nil {
+		return nil
+	}
+	err := r.lock.Close()
+	r.lock = nil
+	return err
+}
+
+// migrate builds a fresh registry, importing the legacy single wallet file
+// (common.WalletFile()) as "default" if one exists.
+func migrate() (*Registry, error) {
+	r := &Registry{Wallets: map[string]Entry{}}
+	legacy := common.WalletFile()
+	if _, err := os.Stat(legacy); err == nil {
+		r.Wallets["default"] = Entry{Name: "default", Path: legacy}
+		r.Default = "default"
+	}
+	if err := r.Save(); err != nil {
+		return nil, fmt.Errorf("migrating legacy wallet into registry: %w", err)
+	}
+	return r, nil
+}
+
+// Save writes the registry to disk atomically: it writes to a temp file in
+// the same directory and renames it over the registry file, so a reader
+// never observes a partially-written file. If r came from LoadForUpdate,
+// Save also releases its lock once the write completes (or fails).
+func (r *Registry) Save() error {
+	if r.lock != nil {
+		defer r.Close()
+	}
+
+	dir := common.DotDirectory()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, fileName+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temp registry file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // no-op once the rename below succeeds
+
+	enc := json.NewEncoder(tmp)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing registry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temp registry file: %w", err)
+	}
+
+	return os.Rename(tmpName, File())
+}
+
+// Add registers a new named wallet. It refuses to overwrite an existing
+// name.
+func (r *Registry) Add(name, path string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.Wallets[name]; exists {
+		return fmt.Errorf("a wallet named %q already exists", name)
+	}
+	if r.Wallets == nil {
+		r.Wallets = map[string]Entry{}
+	}
+	r.Wallets[name] = Entry{Name: name, Path: path}
+	if r.Default == "" {
+		r.Default = name
+	}
+	return nil
+}
+
+// ValidateName rejects wallet names that aren't safe to use as a bare
+// filename component, since callers (e.g. runCreate) build the wallet's
+// file path by joining it onto common.DotDirectory().
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("wallet name must not be empty")
+	}
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return fmt.Errorf("wallet name %q must not contain a path separator or be \".\"/\"..\"", name)
+	}
+	return nil
+}
+
+// Rename renames a registered wallet, preserving its default status if it
+// was the default.
+func (r *Registry) Rename(oldName, newName string) error {
+	entry, ok := r.Wallets[oldName]
+	if !ok {
+		return fmt.Errorf("no wallet named %q", oldName)
+	}
+	if err := ValidateName(newName); err != nil {
+		return err
+	}
+	if _, exists := r.Wallets[newName]; exists {
+		return fmt.Errorf("a wallet named %q already exists", newName)
+	}
+	delete(r.Wallets, oldName)
+	entry.Name = newName
+	r.Wallets[newName] = entry
+	if r.Default == oldName {
+		r.Default = newName
+	}
+	return nil
+}
+
+// SetDefault marks name as the default wallet.
+func (r *Registry) SetDefault(name string) error {
+	if _, ok := r.Wallets[name]; !ok {
+		return fmt.Errorf("no wallet named %q", name)
+	}
+	r.Default = name
+	return nil
+}
+
+// Resolve returns the file path for the given wallet name. If name is
+// empty, it returns the default wallet's path.
+func (r *Registry) Resolve(name string) (string, error) {
+	if name == "" {
+		name = r.Default
+	}
+	if name == "" {
+		return "", fmt.Errorf("no wallet name given and no default wallet is set; " +
+			"use --name or run `smcli wallet default <name>`")
+	}
+	entry, ok := r.Wallets[name]
+	if !ok {
+		return "", fmt.Errorf("no wallet named %q", name)
+	}
+	return entry.Path, nil
+}
@@ -0,0 +1,251 @@
+package registry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spacemeshos/smcli/common"
+)
+
+// withTempHome points common.DotDirectory() at a fresh temp directory for
+// the duration of the test.
+func withTempHome(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+}
+
+func TestLoadCreatesEmptyRegistry(t *testing.T) {
+	withTempHome(t)
+
+	r, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(r.Wallets) != 0 {
+		t.Fatalf("got %d wallets, want 0", len(r.Wallets))
+	}
+	if _, err := os.Stat(File()); err != nil {
+		t.Fatalf("Load didn't create a registry file: %v", err)
+	}
+}
+
+func TestLoadMigratesLegacyWallet(t *testing.T) {
+	withTempHome(t)
+
+	legacy := common.WalletFile()
+	if err := os.MkdirAll(filepath.Dir(legacy), 0o700); err != nil {
+		t.Fatalf("creating legacy wallet dir: %v", err)
+	}
+	if err := os.WriteFile(legacy, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("writing legacy wallet: %v", err)
+	}
+
+	r, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	entry, ok := r.Wallets["default"]
+	if !ok {
+		t.Fatalf("legacy wallet wasn't migrated into the registry")
+	}
+	if entry.Path != legacy {
+		t.Fatalf("migrated entry path = %q, want %q", entry.Path, legacy)
+	}
+	if r.Default != "default" {
+		t.Fatalf("Default = %q, want %q", r.Default, "default")
+	}
+}
+
+func TestAddRenameSetDefaultResolve(t *testing.T) {
+	withTempHome(t)
+
+	r, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("LoadForUpdate: %v", err)
+	}
+	if err := r.Add("alice", "/path/to/alice.json"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := r.Add("bob", "/path/to/bob.json"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := r.Add("alice", "/path/to/other.json"); err == nil {
+		t.Fatalf("Add with a duplicate name should have failed")
+	}
+
+	if err := r.Rename("alice", "alicia"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if _, ok := r.Wallets["alice"]; ok {
+		t.Fatalf("old name %q still present after Rename", "alice")
+	}
+
+	if err := r.SetDefault("bob"); err != nil {
+		t.Fatalf("SetDefault: %v", err)
+	}
+	path, err := r.Resolve("")
+	if err != nil {
+		t.Fatalf("Resolve(\"\"): %v", err)
+	}
+	if path != "/path/to/bob.json" {
+		t.Fatalf("Resolve(\"\") = %q, want %q", path, "/path/to/bob.json")
+	}
+
+	path, err = r.Resolve("alicia")
+	if err != nil {
+		t.Fatalf("Resolve(%q): %v", "alicia", err)
+	}
+	if path != "/path/to/alice.json" {
+		t.Fatalf("Resolve(%q) = %q, want %q", "alicia", path, "/path/to/alice.json")
+	}
+
+	if _, err := r.Resolve("nobody"); err == nil {
+		t.Fatalf("Resolve of an unknown name should have failed")
+	}
+
+	if err := r.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	withTempHome(t)
+
+	r, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("LoadForUpdate: %v", err)
+	}
+	if err := r.Add("alice", "/path/to/alice.json"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := r.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	r2, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if r2.Default != "alice" || r2.Wallets["alice"].Path != "/path/to/alice.json" {
+		t.Fatalf("reloaded registry = %+v, want alice registered as default", r2)
+	}
+
+	raw, err := os.ReadFile(File())
+	if err != nil {
+		t.Fatalf("reading registry file: %v", err)
+	}
+	var onDisk Registry
+	if err := json.Unmarshal(raw, &onDisk); err != nil {
+		t.Fatalf("registry file isn't valid JSON: %v", err)
+	}
+}
+
+// TestConcurrentLoadMigratesOnce guards against the first-run migration
+// race: two unlocked Loads racing to migrate a fresh ~/.smcli must not let
+// the second one's Save silently clobber the first's.
+func TestConcurrentLoadMigratesOnce(t *testing.T) {
+	withTempHome(t)
+
+	legacy := common.WalletFile()
+	if err := os.MkdirAll(filepath.Dir(legacy), 0o700); err != nil {
+		t.Fatalf("creating legacy wallet dir: %v", err)
+	}
+	if err := os.WriteFile(legacy, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("writing legacy wallet: %v", err)
+	}
+
+	const n = 8
+	results := make([]*Registry, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = Load()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Load #%d: %v", i, err)
+		}
+		if results[i].Wallets["default"].Path != legacy {
+			t.Fatalf("Load #%d didn't see the migrated legacy wallet: %+v", i, results[i])
+		}
+	}
+
+	raw, err := os.ReadFile(File())
+	if err != nil {
+		t.Fatalf("reading registry file: %v", err)
+	}
+	var onDisk Registry
+	if err := json.Unmarshal(raw, &onDisk); err != nil {
+		t.Fatalf("registry file isn't valid JSON: %v", err)
+	}
+	if onDisk.Wallets["default"].Path != legacy {
+		t.Fatalf("on-disk registry lost the migrated legacy wallet: %+v", onDisk)
+	}
+}
+
+func TestLoadForUpdateThenSaveReleasesLock(t *testing.T) {
+	withTempHome(t)
+
+	r, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("first LoadForUpdate: %v", err)
+	}
+	if err := r.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// If Save didn't release the lock, this would block forever.
+	r2, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("second LoadForUpdate: %v", err)
+	}
+	if err := r2.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestLoadForUpdateThenCloseReleasesLock(t *testing.T) {
+	withTempHome(t)
+
+	r, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("first LoadForUpdate: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// If Close didn't release the lock, this would block forever.
+	r2, err := LoadForUpdate()
+	if err != nil {
+		t.Fatalf("second LoadForUpdate: %v", err)
+	}
+	if err := r2.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	bad := []string{"", ".", "..", "a/b", `a\b`, "../escape"}
+	for _, name := range bad {
+		if err := ValidateName(name); err == nil {
+			t.Errorf("ValidateName(%q) = nil, want an error", name)
+		}
+	}
+
+	good := []string{"alice", "my-wallet", "wallet.1"}
+	for _, name := range good {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q) = %v, want nil", name, err)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package proto holds the protobuf source for smcli's wallet daemon RPCs.
+// Run `go generate ./...` (with protoc and the Go gRPC plugins on PATH) to
+// regenerate rpc/pb from wallet.proto after editing it.
+package proto
+
+//go:generate protoc --go_out=../pb --go_opt=paths=source_relative --go-grpc_out=../pb --go-grpc_opt=paths=source_relative wallet.proto
@@ -0,0 +1,367 @@
+// Package server implements smcli's wallet daemon: a long-lived process
+// that holds a decrypted wallet in memory between an explicit Unlock and
+// the next Lock (explicit, or automatic on timeout), and exposes signing
+// and node operations over gRPC so callers don't need to re-enter the
+// wallet password for every command.
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	spb "github.com/spacemeshos/api/release/go/spacemesh/v1"
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/genvm/sdk"
+	walletSdk "github.com/spacemeshos/go-spacemesh/genvm/sdk/wallet"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/spacemeshos/smcli/internal/zero"
+	"github.com/spacemeshos/smcli/rpc/pb"
+	"github.com/spacemeshos/smcli/wallet"
+)
+
+// authMetadataKey is the gRPC metadata key clients must set to the
+// daemon's token on every call.
+const authMetadataKey = "authorization"
+
+// Server implements pb.WalletServiceServer against a single wallet file.
+type Server struct {
+	pb.UnimplementedWalletServiceServer
+
+	walletFn string
+	nodeURI  string
+	hrp      string
+	token    string
+
+	mu        sync.Mutex
+	w         *wallet.Wallet
+	lockTimer *time.Timer
+}
+
+// New returns a Server backed by the wallet at walletFn, talking to the
+// node at nodeURI. The wallet starts out locked; callers must Unlock
+// before signing or submitting transactions. Every call must carry token
+// in its "authorization" gRPC metadata, so that any local process that
+// can reach the listen address still can't drive the wallet without it.
+func New(walletFn, nodeURI, hrp, token string) *Server {
+	return &Server{walletFn: walletFn, nodeURI: nodeURI, hrp: hrp, token: token}
+}
+
+// authUnaryInterceptor rejects any call whose "authorization" metadata
+// doesn't match srv's token.
+func authUnaryInterceptor(srv *Server) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || !tokenMatches(md.Get(authMetadataKey), srv.token) {
+			return nil, status.Error(codes.Unauthenticated, "missing or incorrect authorization token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// tokenMatches reports whether got (the values of an incoming
+// "authorization" metadata key) contains exactly want, in constant time so
+// the daemon doesn't leak how much of the token a caller guessed right.
+func tokenMatches(got []string, want string) bool {
+	return len(got) == 1 && subtle.ConstantTimeCompare([]byte(got[0]), []byte(want)) == 1
+}
+
+// Unlock decrypts the wallet file and holds it in memory until Lock is
+// called or timeout_seconds elapses, whichever comes first.
+func (s *Server) Unlock(ctx context.Context, req *pb.UnlockRequest) (*pb.UnlockResponse, error) {
+	f, err := os.Open(s.walletFn)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "opening wallet file: %v", err)
+	}
+	defer f.Close()
+
+	passBytes := []byte(req.Password)
+	defer zero.Bytes(passBytes)
+	wk := wallet.NewKey(wallet.WithPasswordOnly(passBytes))
+	w, err := wk.Open(f, false)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "opening wallet: %v", err)
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lockLocked()
+	s.w = w
+	s.lockTimer = time.AfterFunc(timeout, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.lockLocked()
+	})
+
+	return &pb.UnlockResponse{ExpiresAtUnix: time.Now().Add(timeout).Unix()}, nil
+}
+
+// Lock immediately zeroes the in-memory wallet.
+func (s *Server) Lock(ctx context.Context, req *pb.LockRequest) (*pb.LockResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lockLocked()
+	return &pb.LockResponse{}, nil
+}
+
+// lockLocked zeroes and drops the in-memory wallet. Callers must hold s.mu.
+func (s *Server) lockLocked() {
+	if s.lockTimer != nil {
+		s.lockTimer.Stop()
+		s.lockTimer = nil
+	}
+	if s.w != nil {
+		s.w.Close()
+		s.w = nil
+	}
+}
+
+// unlockedWallet returns the in-memory wallet, or an error if it's locked.
+// Callers must hold s.mu.
+func (s *Server) unlockedWallet() (*wallet.Wallet, error) {
+	if s.w == nil {
+		return nil, status.Error(codes.FailedPrecondition, "wallet is locked; call Unlock first")
+	}
+	return s.w, nil
+}
+
+// ListAccounts lists the wallet's accounts. It requires the wallet to be
+// unlocked, since the account list itself is only known once decrypted.
+func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, err := s.unlockedWallet()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListAccountsResponse{}
+	for i, a := range w.Secrets.Accounts {
+		resp.Accounts = append(resp.Accounts, &pb.Account{
+			Index:       int32(i),
+			Address:     string(wallet.PubkeyToAddress(a.Public, s.hrp)),
+			Path:        a.Path.String(),
+			DisplayName: a.DisplayName,
+		})
+	}
+	return resp, nil
+}
+
+// Sign signs a message with the given account's private key.
+func (s *Server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, err := s.unlockedWallet()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := accountAt(w, int(req.AccountIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	sk := ed25519.PrivateKey(account.Private)
+	sig, err := sk.Sign(nil, req.Message, crypto.Hash(0))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "signing: %v", err)
+	}
+	return &pb.SignResponse{Signature: sig, PublicKey: account.Public}, nil
+}
+
+// GetBalance fetches an account's balance from the configured node.
+func (s *Server) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceResponse, error) {
+	account, err := s.accountByIndex(int(req.AccountIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	nodeConn, err := s.dialNode()
+	if err != nil {
+		return nil, err
+	}
+	defer nodeConn.Close()
+
+	address := wallet.PubkeyToAddress(account.Public, s.hrp)
+	client := spb.NewGlobalStateServiceClient(nodeConn)
+	resp, err := client.Account(ctx, &spb.AccountRequest{AccountId: &spb.AccountId{Address: string(address)}})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "querying node: %v", err)
+	}
+	return &pb.GetBalanceResponse{Balance: resp.AccountWrapper.StateProjected.Balance.Value}, nil
+}
+
+// Spawn submits a self-spawn transaction for an account.
+func (s *Server) Spawn(ctx context.Context, req *pb.SpawnRequest) (*pb.SpawnResponse, error) {
+	s.mu.Lock()
+	w, err := s.unlockedWallet()
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	account, err := accountAt(w, int(req.AccountIndex))
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	privCopy := append([]byte(nil), account.Private...)
+	s.mu.Unlock()
+	defer zero.Bytes(privCopy)
+
+	nodeConn, err := s.dialNode()
+	if err != nil {
+		return nil, err
+	}
+	defer nodeConn.Close()
+
+	genesisID, err := s.genesisID(ctx, nodeConn)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := walletSdk.SelfSpawn(ed25519.PrivateKey(privCopy), 0, sdk.WithGenesisID(genesisID))
+	txClient := spb.NewTransactionServiceClient(nodeConn)
+	resp, err := txClient.SubmitTransaction(ctx, &spb.SubmitTransactionRequest{Transaction: tx})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "submitting transaction: %v", err)
+	}
+	return &pb.SpawnResponse{TxId: hex.EncodeToString(resp.Txstate.Id.Id)}, nil
+}
+
+// Send submits a spend transaction from an account. If nonce is unset, the
+// sender's projected counter (which counts mempool transactions) + 1, as
+// reported by the node, is used.
+func (s *Server) Send(ctx context.Context, req *pb.SendRequest) (*pb.SendResponse, error) {
+	s.mu.Lock()
+	w, err := s.unlockedWallet()
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	account, err := accountAt(w, int(req.AccountIndex))
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	privCopy := append([]byte(nil), account.Private...)
+	s.mu.Unlock()
+	defer zero.Bytes(privCopy)
+
+	recipient, err := types.StringToAddress(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing recipient: %v", err)
+	}
+
+	nodeConn, err := s.dialNode()
+	if err != nil {
+		return nil, err
+	}
+	defer nodeConn.Close()
+
+	genesisID, err := s.genesisID(ctx, nodeConn)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := uint64(0)
+	if req.Nonce != nil {
+		nonce = *req.Nonce
+	} else {
+		senderAddress := wallet.PubkeyToAddress(account.Public, s.hrp)
+		globalStateClient := spb.NewGlobalStateServiceClient(nodeConn)
+		accountResp, err := globalStateClient.Account(ctx, &spb.AccountRequest{AccountId: &spb.AccountId{Address: string(senderAddress)}})
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "querying node: %v", err)
+		}
+		nonce = accountResp.AccountWrapper.StateProjected.Counter + 1
+	}
+
+	tx := walletSdk.Spend(ed25519.PrivateKey(privCopy), recipient, req.Amount, nonce, sdk.WithGenesisID(genesisID))
+	txClient := spb.NewTransactionServiceClient(nodeConn)
+	resp, err := txClient.SubmitTransaction(ctx, &spb.SubmitTransactionRequest{Transaction: tx})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "submitting transaction: %v", err)
+	}
+	return &pb.SendResponse{TxId: hex.EncodeToString(resp.Txstate.Id.Id)}, nil
+}
+
+func (s *Server) accountByIndex(idx int) (*wallet.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, err := s.unlockedWallet()
+	if err != nil {
+		return nil, err
+	}
+	return accountAt(w, idx)
+}
+
+func accountAt(w *wallet.Wallet, idx int) (*wallet.Account, error) {
+	if idx < 0 || idx >= len(w.Secrets.Accounts) {
+		return nil, status.Errorf(codes.InvalidArgument, "no account at index %d", idx)
+	}
+	return w.Secrets.Accounts[idx], nil
+}
+
+func (s *Server) dialNode() (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(s.nodeURI, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing node: %v", err)
+	}
+	return conn, nil
+}
+
+func (s *Server) genesisID(ctx context.Context, conn *grpc.ClientConn) (types.Hash20, error) {
+	meshClient := spb.NewMeshServiceClient(conn)
+	resp, err := meshClient.GenesisID(ctx, &spb.GenesisIDRequest{})
+	if err != nil {
+		return types.Hash20{}, status.Errorf(codes.Unavailable, "fetching genesis ID: %v", err)
+	}
+	return types.Hash20(resp.GenesisId), nil
+}
+
+// Serve starts a gRPC server bound to listenAddr, serving srv, and blocks
+// until ctx is canceled or the listener fails. It is expected to be called
+// with a localhost listen address unless the caller has taken explicit
+// steps to secure the endpoint.
+func Serve(ctx context.Context, listenAddr string, srv *Server) error {
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+	return serve(ctx, lis, srv)
+}
+
+// serve is Serve's listener-taking core, split out so tests can bind an
+// ephemeral port (":0") instead of guessing at a free, fixed one.
+func serve(ctx context.Context, lis net.Listener, srv *Server) error {
+	gs := grpc.NewServer(grpc.UnaryInterceptor(authUnaryInterceptor(srv)))
+	pb.RegisterWalletServiceServer(gs, srv)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- gs.Serve(lis) }()
+
+	select {
+	case <-ctx.Done():
+		gs.GracefulStop()
+		_, _ = srv.Lock(context.Background(), &pb.LockRequest{})
+		return ctx.Err()
+	case err := <-errCh:
+		return err
+	}
+}
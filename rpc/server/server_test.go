@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/spacemeshos/smcli/rpc/pb"
+	"github.com/spacemeshos/smcli/wallet"
+)
+
+// writeTestWallet creates a fresh, unencrypted wallet file (no password) in
+// a temp directory and returns its path.
+func writeTestWallet(t *testing.T) string {
+	t.Helper()
+	w, err := wallet.NewMultiWalletRandomMnemonic(1)
+	if err != nil {
+		t.Fatalf("creating wallet: %v", err)
+	}
+	defer w.Close()
+
+	walletFn := filepath.Join(t.TempDir(), "wallet.json")
+	f, err := os.Create(walletFn)
+	if err != nil {
+		t.Fatalf("creating wallet file: %v", err)
+	}
+	defer f.Close()
+	if err := wallet.NewKey().Export(f, w); err != nil {
+		t.Fatalf("exporting wallet: %v", err)
+	}
+	return walletFn
+}
+
+// startTestServer serves srv on an ephemeral localhost port and returns a
+// client connection to it. Both are torn down automatically at test end.
+func startTestServer(t *testing.T, srv *Server) *grpc.ClientConn {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- serve(ctx, lis, srv) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dialing test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, authMetadataKey, token)
+}
+
+func TestUnlockListAccountsLock(t *testing.T) {
+	srv := New(writeTestWallet(t), "", "sm", "s3cr3t")
+	client := pb.NewWalletServiceClient(startTestServer(t, srv))
+	ctx := context.Background()
+
+	if _, err := client.ListAccounts(withToken(ctx, "s3cr3t"), &pb.ListAccountsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListAccounts on a locked wallet: got %v, want FailedPrecondition", err)
+	}
+
+	if _, err := client.Unlock(withToken(ctx, "s3cr3t"), &pb.UnlockRequest{TimeoutSeconds: 60}); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	resp, err := client.ListAccounts(withToken(ctx, "s3cr3t"), &pb.ListAccountsRequest{})
+	if err != nil {
+		t.Fatalf("ListAccounts after Unlock: %v", err)
+	}
+	if len(resp.Accounts) != 1 {
+		t.Fatalf("got %d accounts, want 1", len(resp.Accounts))
+	}
+
+	if _, err := client.Lock(withToken(ctx, "s3cr3t"), &pb.LockRequest{}); err != nil {
+		t.Fatalf("Lock: %v", err)
+	}
+	if _, err := client.ListAccounts(withToken(ctx, "s3cr3t"), &pb.ListAccountsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListAccounts after Lock: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestAuthRejectsMissingOrWrongToken(t *testing.T) {
+	srv := New(writeTestWallet(t), "", "sm", "s3cr3t")
+	client := pb.NewWalletServiceClient(startTestServer(t, srv))
+	ctx := context.Background()
+
+	if _, err := client.ListAccounts(ctx, &pb.ListAccountsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("ListAccounts with no token: got %v, want Unauthenticated", err)
+	}
+	if _, err := client.ListAccounts(withToken(ctx, "wrong"), &pb.ListAccountsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("ListAccounts with wrong token: got %v, want Unauthenticated", err)
+	}
+}
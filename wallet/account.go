@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DerivationPath is a BIP-32 derivation path, expressed as a sequence of
+// (possibly hardened) indices below the wallet's master key.
+type DerivationPath []uint32
+
+// hardenedBit marks an index as hardened, per BIP-32.
+const hardenedBit = uint32(0x80000000)
+
+// String renders the path the way the rest of the tool (and the BIP-44
+// spec) expects, e.g., "44'/540'/0'/0'".
+func (p DerivationPath) String() string {
+	parts := make([]string, len(p))
+	for i, idx := range p {
+		if idx&hardenedBit != 0 {
+			parts[i] = fmt.Sprintf("%d'", idx&^hardenedBit)
+		} else {
+			parts[i] = fmt.Sprintf("%d", idx)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// ParseDerivationPath parses the string representation produced by String,
+// e.g. "44'/540'/0'/0'", back into a DerivationPath.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	parts := strings.Split(s, "/")
+	path := make(DerivationPath, len(parts))
+	for i, part := range parts {
+		hardened := strings.HasSuffix(part, "'")
+		part = strings.TrimSuffix(part, "'")
+		idx, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parsing derivation path %q: %w", s, err)
+		}
+		if hardened {
+			path[i] = uint32(idx) | hardenedBit
+		} else {
+			path[i] = uint32(idx)
+		}
+	}
+	return path, nil
+}
+
+// Account is a single keypair within a wallet, either the master keypair
+// or one of its BIP-32 children.
+type Account struct {
+	// Path is the BIP-32 derivation path used to derive this account from
+	// the wallet's mnemonic. It is empty for imported accounts that did
+	// not carry path information.
+	Path DerivationPath `json:"path"`
+
+	// DisplayName is a user-assigned label for this account.
+	DisplayName string `json:"displayName"`
+
+	// Created is when this account was added to the wallet.
+	Created time.Time `json:"created"`
+
+	// Public is the account's ed25519 public key.
+	Public []byte `json:"public"`
+
+	// Private is the account's ed25519 private key. It is nil for
+	// Ledger-backed wallets, which never hold private key material.
+	Private []byte `json:"private,omitempty"`
+}
@@ -0,0 +1,296 @@
+// Package history implements transaction history and rescan support for
+// smcli: it paginates through a Spacemesh node's GlobalStateService data
+// for each wallet account, classifies transactions relative to that
+// account, and surfaces the account's nonce and pending mempool activity.
+// It's kept separate from cmd so other callers, such as the wallet daemon
+// in rpc/server, can reuse it without importing cobra.
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/spacemeshos/go-scale"
+	"github.com/spacemeshos/go-spacemesh/genvm/core"
+	gvmwallet "github.com/spacemeshos/go-spacemesh/genvm/templates/wallet"
+
+	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
+	"google.golang.org/grpc"
+
+	"github.com/spacemeshos/smcli/wallet"
+)
+
+// pageSize is how many results to request per AccountDataQuery page.
+const pageSize = 100
+
+// Direction classifies a transaction relative to the account whose history
+// it appears in.
+type Direction string
+
+const (
+	DirectionIncoming Direction = "incoming"
+	DirectionOutgoing Direction = "outgoing"
+	DirectionSpawn    Direction = "spawn"
+)
+
+// Entry is a single transaction in an account's history.
+type Entry struct {
+	Layer        uint32    `json:"layer"`
+	TxID         string    `json:"txId"`
+	Counterparty string    `json:"counterparty"`
+	Direction    Direction `json:"direction"`
+	Amount       uint64    `json:"amount"`
+	Gas          uint64    `json:"gas"`
+	Status       string    `json:"status"`
+}
+
+// AccountHistory is one account's transaction history plus enough live
+// state to debug a stuck send: its current nonce, and how many
+// transactions the node has accepted into the mempool but not yet applied
+// (the gap between the account's projected and current counters).
+type AccountHistory struct {
+	Address string  `json:"address"`
+	Nonce   uint64  `json:"nonce"`
+	Pending uint64  `json:"pending"`
+	Entries []Entry `json:"entries"`
+}
+
+// Fetch retrieves the transaction history of every account in accounts,
+// starting at fromLayer (0 for the full history), over conn.
+func Fetch(
+	ctx context.Context, conn *grpc.ClientConn, accounts []*wallet.Account, hrp string, fromLayer uint32,
+) ([]AccountHistory, error) {
+	globalStateClient := pb.NewGlobalStateServiceClient(conn)
+	txClient := pb.NewTransactionServiceClient(conn)
+
+	histories := make([]AccountHistory, len(accounts))
+	for i, a := range accounts {
+		address := string(wallet.PubkeyToAddress(a.Public, hrp))
+
+		accountResp, err := globalStateClient.Account(ctx, &pb.AccountRequest{
+			AccountId: &pb.AccountId{Address: address},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("querying account %s: %w", address, err)
+		}
+
+		entries, err := fetchEntries(ctx, globalStateClient, txClient, address, hrp, fromLayer)
+		if err != nil {
+			return nil, fmt.Errorf("fetching history for %s: %w", address, err)
+		}
+
+		current := accountResp.AccountWrapper.StateCurrent.Counter
+		projected := accountResp.AccountWrapper.StateProjected.Counter
+		histories[i] = AccountHistory{
+			Address: address,
+			Nonce:   current,
+			Pending: projected - current,
+			Entries: entries,
+		}
+	}
+	return histories, nil
+}
+
+// fetchEntries pages through an account's transaction receipts via
+// AccountDataQuery. A receipt only carries the gas/fee/layer the node
+// charged, not who sent or received the transaction, so each page's
+// receipts are matched up against the underlying transactions (fetched in
+// the same batch via TransactionsState) before classifying them relative
+// to address.
+func fetchEntries(
+	ctx context.Context, client pb.GlobalStateServiceClient, txClient pb.TransactionServiceClient,
+	address, hrp string, fromLayer uint32,
+) ([]Entry, error) {
+	var entries []Entry
+	offset := uint32(0)
+	for {
+		resp, err := client.AccountDataQuery(ctx, &pb.AccountDataQueryRequest{
+			Filter: &pb.AccountDataFilter{
+				AccountId:        &pb.AccountId{Address: address},
+				AccountDataFlags: uint32(pb.AccountDataFlag_ACCOUNT_DATA_FLAG_TRANSACTION_RECEIPT),
+			},
+			MaxResults: pageSize,
+			Offset:     offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var receipts []*pb.TransactionReceipt
+		for _, item := range resp.AccountItem {
+			receipt := item.GetReceipt()
+			if receipt == nil {
+				continue
+			}
+			if receipt.Layer != nil && receipt.Layer.Number < fromLayer {
+				continue
+			}
+			receipts = append(receipts, receipt)
+		}
+
+		txs, err := fetchTransactions(ctx, txClient, receipts)
+		if err != nil {
+			return nil, fmt.Errorf("fetching transactions: %w", err)
+		}
+		for _, receipt := range receipts {
+			entries = append(entries, classify(address, hrp, receipt, txs[hex.EncodeToString(receipt.Id.GetId())]))
+		}
+
+		offset += uint32(len(resp.AccountItem))
+		if len(resp.AccountItem) == 0 || offset >= resp.TotalResults {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// fetchTransactions retrieves the transactions backing receipts in a single
+// round trip, keyed by hex-encoded transaction ID.
+func fetchTransactions(
+	ctx context.Context, txClient pb.TransactionServiceClient, receipts []*pb.TransactionReceipt,
+) (map[string]*pb.Transaction, error) {
+	txs := make(map[string]*pb.Transaction, len(receipts))
+	if len(receipts) == 0 {
+		return txs, nil
+	}
+
+	ids := make([]*pb.TransactionId, len(receipts))
+	for i, receipt := range receipts {
+		ids[i] = receipt.Id
+	}
+
+	resp, err := txClient.TransactionsState(ctx, &pb.TransactionsStateRequest{
+		TransactionId:       ids,
+		IncludeTransactions: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range resp.Transactions {
+		txs[hex.EncodeToString(tx.Id)] = tx
+	}
+	return txs, nil
+}
+
+// classify determines an entry's direction and counterparty relative to
+// address. A Transaction only carries its principal (the account whose
+// nonce and balance the call is charged against) and the raw SVM-encoded
+// call; the recipient and amount of a spend are only recoverable by
+// decoding that payload. Rather than failing the whole history fetch, a
+// missing tx degrades to an outgoing entry with an empty counterparty, and
+// a tx whose payload doesn't decode degrades to an outgoing entry whose
+// counterparty is the tx's principal.
+func classify(address, hrp string, receipt *pb.TransactionReceipt, tx *pb.Transaction) Entry {
+	var layer uint32
+	if receipt.Layer != nil {
+		layer = receipt.Layer.Number
+	}
+
+	entry := Entry{
+		Layer:  layer,
+		TxID:   hex.EncodeToString(receipt.Id.GetId()),
+		Gas:    receipt.GasUsed,
+		Status: receipt.Result.String(),
+	}
+
+	if tx == nil {
+		entry.Direction = DirectionOutgoing
+		return entry
+	}
+
+	sender := tx.Principal.GetAddress()
+	method, destination, amount, err := decodeCall(tx.Raw, hrp)
+	if err != nil {
+		// Raw didn't decode as a single-sig spawn/spend (e.g. a
+		// multisig or vesting-template call); report the principal the
+		// wire message told us as the counterparty, since the actual
+		// recipient can't be resolved.
+		entry.Direction = DirectionOutgoing
+		entry.Counterparty = sender
+		return entry
+	}
+
+	switch method {
+	case core.MethodSpawn:
+		entry.Direction = DirectionSpawn
+		entry.Counterparty = sender
+	case core.MethodSpend:
+		entry.Amount = amount
+		if sender == address {
+			entry.Direction = DirectionOutgoing
+			entry.Counterparty = destination
+		} else {
+			entry.Direction = DirectionIncoming
+			entry.Counterparty = sender
+		}
+	default:
+		entry.Direction = DirectionOutgoing
+		entry.Counterparty = sender
+	}
+	return entry
+}
+
+// decodeCall parses enough of a signed single-sig transaction's raw SVM
+// payload to recover its method and, for a spend, the destination address
+// and amount — fields the TransactionService wire message doesn't carry.
+// The layout (version, principal, method, template-if-spawn, payload,
+// method arguments) mirrors what genvm/sdk/wallet.SelfSpawn and .Spend
+// encode.
+func decodeCall(raw []byte, hrp string) (method uint8, destination string, amount uint64, err error) {
+	dec := scale.NewDecoder(bytes.NewReader(raw))
+
+	if _, _, err = scale.DecodeCompact8(dec); err != nil {
+		return 0, "", 0, fmt.Errorf("decoding version: %w", err)
+	}
+
+	var principal core.Address
+	if _, err = principal.DecodeScale(dec); err != nil {
+		return 0, "", 0, fmt.Errorf("decoding principal: %w", err)
+	}
+
+	m, _, err := scale.DecodeCompact8(dec)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("decoding method: %w", err)
+	}
+	method = m
+
+	if method == core.MethodSpawn {
+		var template core.Address
+		if _, err = template.DecodeScale(dec); err != nil {
+			return method, "", 0, fmt.Errorf("decoding template: %w", err)
+		}
+	}
+
+	var payload core.Payload
+	if _, err = payload.DecodeScale(dec); err != nil {
+		return method, "", 0, fmt.Errorf("decoding payload: %w", err)
+	}
+
+	if method != core.MethodSpend {
+		return method, "", 0, nil
+	}
+
+	var args gvmwallet.SpendArguments
+	if _, err = args.DecodeScale(dec); err != nil {
+		return method, "", 0, fmt.Errorf("decoding spend arguments: %w", err)
+	}
+	return method, addressToString(args.Destination, hrp), args.Amount, nil
+}
+
+// addressToString bech32-encodes a raw genvm account address the same way
+// wallet.PubkeyToAddress encodes a derived one, but parameterized on hrp
+// rather than the genvm package's mutable global network HRP.
+func addressToString(addr core.Address, hrp string) string {
+	data, err := bech32.ConvertBits(addr[:], 8, 5, true)
+	if err != nil {
+		panic(fmt.Sprintf("converting address bits: %v", err))
+	}
+	encoded, err := bech32.Encode(hrp, data)
+	if err != nil {
+		panic(fmt.Sprintf("bech32-encoding address: %v", err))
+	}
+	return encoded
+}
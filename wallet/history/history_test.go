@@ -0,0 +1,174 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/genvm/core"
+	gvmwallet "github.com/spacemeshos/go-spacemesh/genvm/sdk/wallet"
+	"github.com/spacemeshos/go-spacemesh/signing"
+
+	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
+)
+
+// newTestSigner returns a random signer to build test transactions with.
+func newTestSigner(t *testing.T) *signing.EdSigner {
+	t.Helper()
+	signer, err := signing.NewEdSigner()
+	if err != nil {
+		t.Fatalf("generating signer: %v", err)
+	}
+	return signer
+}
+
+// decodeAddress bech32-encodes addr under hrp, the same way addressToString
+// does, so tests can assert decodeCall's and classify's output against it.
+func decodeAddress(t *testing.T, addr core.Address, hrp string) string {
+	t.Helper()
+	data, err := bech32.ConvertBits(addr[:], 8, 5, true)
+	if err != nil {
+		t.Fatalf("converting address bits: %v", err)
+	}
+	encoded, err := bech32.Encode(hrp, data)
+	if err != nil {
+		t.Fatalf("bech32-encoding address: %v", err)
+	}
+	return encoded
+}
+
+func TestDecodeCallSelfSpawn(t *testing.T) {
+	signer := newTestSigner(t)
+	raw := gvmwallet.SelfSpawn(signer.PrivateKey(), 0)
+
+	method, destination, amount, err := decodeCall(raw, "sm")
+	if err != nil {
+		t.Fatalf("decodeCall: %v", err)
+	}
+	if method != core.MethodSpawn {
+		t.Fatalf("method = %d, want %d", method, core.MethodSpawn)
+	}
+	if destination != "" || amount != 0 {
+		t.Fatalf("got destination %q amount %d, want empty/0 for a spawn", destination, amount)
+	}
+}
+
+func TestDecodeCallSpend(t *testing.T) {
+	signer := newTestSigner(t)
+	var to types.Address
+	to[0] = 0xAB
+	raw := gvmwallet.Spend(signer.PrivateKey(), to, 42, 3)
+
+	method, destination, amount, err := decodeCall(raw, "sm")
+	if err != nil {
+		t.Fatalf("decodeCall: %v", err)
+	}
+	if method != core.MethodSpend {
+		t.Fatalf("method = %d, want %d", method, core.MethodSpend)
+	}
+	if amount != 42 {
+		t.Fatalf("amount = %d, want 42", amount)
+	}
+	if want := decodeAddress(t, to, "sm"); destination != want {
+		t.Fatalf("destination = %q, want %q", destination, want)
+	}
+}
+
+func TestDecodeCallTruncated(t *testing.T) {
+	if _, _, _, err := decodeCall([]byte{0x00}, "sm"); err == nil {
+		t.Fatalf("decodeCall on truncated raw bytes should have failed")
+	}
+}
+
+func receiptID(b byte) *pb.TransactionId {
+	return &pb.TransactionId{Id: []byte{b}}
+}
+
+func TestClassifyOutgoingSpend(t *testing.T) {
+	signer := newTestSigner(t)
+	address := "sm1sender"
+	var to types.Address
+	to[0] = 0xCD
+	raw := gvmwallet.Spend(signer.PrivateKey(), to, 7, 0)
+
+	tx := &pb.Transaction{Id: []byte{1}, Principal: &pb.AccountId{Address: address}, Raw: raw}
+	receipt := &pb.TransactionReceipt{
+		Id:      receiptID(1),
+		GasUsed: 100,
+		Result:  pb.TransactionReceipt_TRANSACTION_RESULT_EXECUTED,
+	}
+
+	entry := classify(address, "sm", receipt, tx)
+	if entry.Direction != DirectionOutgoing {
+		t.Fatalf("direction = %q, want %q", entry.Direction, DirectionOutgoing)
+	}
+	if entry.Amount != 7 {
+		t.Fatalf("amount = %d, want 7", entry.Amount)
+	}
+	want := decodeAddress(t, to, "sm")
+	if entry.Counterparty != want {
+		t.Fatalf("counterparty = %q, want %q", entry.Counterparty, want)
+	}
+}
+
+func TestClassifyIncomingSpend(t *testing.T) {
+	signer := newTestSigner(t)
+	sender := "sm1sender"
+	address := "sm1recipient"
+	var to types.Address
+	to[0] = 0xEF
+	raw := gvmwallet.Spend(signer.PrivateKey(), to, 9, 0)
+
+	tx := &pb.Transaction{Id: []byte{2}, Principal: &pb.AccountId{Address: sender}, Raw: raw}
+	receipt := &pb.TransactionReceipt{Id: receiptID(2)}
+
+	entry := classify(address, "sm", receipt, tx)
+	if entry.Direction != DirectionIncoming {
+		t.Fatalf("direction = %q, want %q", entry.Direction, DirectionIncoming)
+	}
+	if entry.Counterparty != sender {
+		t.Fatalf("counterparty = %q, want %q", entry.Counterparty, sender)
+	}
+}
+
+func TestClassifySpawn(t *testing.T) {
+	signer := newTestSigner(t)
+	address := "sm1self"
+	raw := gvmwallet.SelfSpawn(signer.PrivateKey(), 0)
+
+	tx := &pb.Transaction{Id: []byte{3}, Principal: &pb.AccountId{Address: address}, Raw: raw}
+	receipt := &pb.TransactionReceipt{Id: receiptID(3)}
+
+	entry := classify(address, "sm", receipt, tx)
+	if entry.Direction != DirectionSpawn {
+		t.Fatalf("direction = %q, want %q", entry.Direction, DirectionSpawn)
+	}
+	if entry.Counterparty != address {
+		t.Fatalf("counterparty = %q, want %q", entry.Counterparty, address)
+	}
+}
+
+func TestClassifyNoTransactionIsOutgoingUnresolved(t *testing.T) {
+	receipt := &pb.TransactionReceipt{Id: receiptID(4)}
+	entry := classify("sm1self", "sm", receipt, nil)
+	if entry.Direction != DirectionOutgoing {
+		t.Fatalf("direction = %q, want %q", entry.Direction, DirectionOutgoing)
+	}
+	if entry.Counterparty != "" {
+		t.Fatalf("counterparty = %q, want empty", entry.Counterparty)
+	}
+}
+
+func TestClassifyMalformedRawIsOutgoingFromPrincipal(t *testing.T) {
+	address := "sm1self"
+	tx := &pb.Transaction{Id: []byte{5}, Principal: &pb.AccountId{Address: address}, Raw: []byte{0x00}}
+	receipt := &pb.TransactionReceipt{Id: receiptID(5)}
+
+	entry := classify(address, "sm", receipt, tx)
+	if entry.Direction != DirectionOutgoing {
+		t.Fatalf("direction = %q, want %q", entry.Direction, DirectionOutgoing)
+	}
+	if entry.Counterparty != address {
+		t.Fatalf("counterparty = %q, want %q", entry.Counterparty, address)
+	}
+}
@@ -0,0 +1,158 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/spacemeshos/smcli/internal/zero"
+)
+
+const (
+	pbkdf2Iterations = 600_000
+	saltSize         = 16
+	keySize          = 32
+)
+
+// Key knows how to encrypt a Wallet to, and decrypt one from, the on-disk
+// wallet file format.
+type Key struct {
+	salt     []byte
+	password []byte
+}
+
+// KeyOption configures a Key.
+type KeyOption func(*Key)
+
+// WithRandomSalt generates a fresh random salt for a new wallet file.
+func WithRandomSalt() KeyOption {
+	return func(k *Key) {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			panic(err)
+		}
+		k.salt = salt
+	}
+}
+
+// WithPbkdf2Password sets the password used to derive the wallet's
+// encryption key via PBKDF2. It implies the salt has been (or will be) set
+// separately, e.g. via WithRandomSalt when creating a wallet.
+func WithPbkdf2Password(password []byte) KeyOption {
+	return func(k *Key) {
+		k.password = password
+	}
+}
+
+// WithPasswordOnly sets the password used to decrypt an existing wallet
+// file; the salt is read from the file itself in Open.
+func WithPasswordOnly(password []byte) KeyOption {
+	return func(k *Key) {
+		k.password = password
+	}
+}
+
+// NewKey builds a Key from the given options.
+func NewKey(opts ...KeyOption) *Key {
+	k := &Key{}
+	for _, opt := range opts {
+		opt(k)
+	}
+	return k
+}
+
+// walletFile is the on-disk JSON envelope around an encrypted wallet.
+type walletFile struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func (k *Key) derive() []byte {
+	return pbkdf2.Key(k.password, k.salt, pbkdf2Iterations, keySize, sha512.New)
+}
+
+// Export encrypts w under k and writes the resulting wallet file to out.
+func (k *Key) Export(out io.Writer, w *Wallet) error {
+	plaintext, err := json.Marshal(w.Secrets)
+	if err != nil {
+		return fmt.Errorf("marshaling wallet secrets: %w", err)
+	}
+	defer zero.Bytes(plaintext)
+
+	var ciphertext []byte
+	var nonce []byte
+	if len(k.password) == 0 {
+		// no password: store the wallet unencrypted
+		ciphertext = plaintext
+	} else {
+		derivedKey := k.derive()
+		defer zero.Bytes(derivedKey)
+		block, err := aes.NewCipher(derivedKey)
+		if err != nil {
+			return fmt.Errorf("initializing cipher: %w", err)
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return fmt.Errorf("initializing gcm: %w", err)
+		}
+		nonce = make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("generating nonce: %w", err)
+		}
+		ciphertext = gcm.Seal(nil, nonce, plaintext, nil)
+	}
+
+	return json.NewEncoder(out).Encode(walletFile{
+		Salt:       k.salt,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	})
+}
+
+// Open decrypts the wallet file read from in using k's password, returning
+// the resulting Wallet. If debug is true, a decryption error returned for a
+// wrong password wraps the underlying cipher error for additional detail.
+func (k *Key) Open(in io.Reader, debug bool) (*Wallet, error) {
+	var wf walletFile
+	if err := json.NewDecoder(in).Decode(&wf); err != nil {
+		return nil, fmt.Errorf("reading wallet file: %w", err)
+	}
+	k.salt = wf.Salt
+
+	var plaintext []byte
+	if len(wf.Nonce) == 0 {
+		plaintext = wf.Ciphertext
+	} else {
+		derivedKey := k.derive()
+		defer zero.Bytes(derivedKey)
+		block, err := aes.NewCipher(derivedKey)
+		if err != nil {
+			return nil, fmt.Errorf("initializing cipher: %w", err)
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("initializing gcm: %w", err)
+		}
+		plaintext, err = gcm.Open(nil, wf.Nonce, wf.Ciphertext, nil)
+		if err != nil {
+			if debug {
+				return nil, fmt.Errorf("decrypting wallet (wrong password?): %w", err)
+			}
+			return nil, fmt.Errorf("decrypting wallet: incorrect password")
+		}
+	}
+	defer zero.Bytes(plaintext)
+
+	var secrets Secrets
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("parsing wallet contents: %w", err)
+	}
+	return &Wallet{Secrets: secrets}, nil
+}
@@ -0,0 +1,218 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/spacemeshos/smcli/internal/zero"
+)
+
+// Keystore scrypt parameters, matching the go-ethereum "standard" preset.
+const (
+	keystoreScryptN       = 262144
+	keystoreScryptR       = 8
+	keystoreScryptP       = 1
+	keystoreScryptDKLen   = 32
+	keystoreScryptSaltLen = 32
+)
+
+// keystoreV3 is an Ethereum-style encrypted keystore JSON v3 document,
+// adapted to carry an ed25519 keypair instead of secp256k1: `curve`
+// disambiguates it from a real Ethereum keystore, and `path` optionally
+// preserves the account's BIP-32 derivation path across export/import.
+type keystoreV3 struct {
+	Version int              `json:"version"`
+	Address string           `json:"address"`
+	Curve   string           `json:"curve"`
+	Path    string           `json:"path,omitempty"`
+	Crypto  keystoreV3Crypto `json:"crypto"`
+}
+
+type keystoreV3Crypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherParams keystoreCipherParams `json:"cipherparams"`
+	CipherText   string               `json:"ciphertext"`
+	KDF          string               `json:"kdf"`
+	KDFParams    keystoreKDFParams    `json:"kdfparams"`
+	MAC          string               `json:"mac"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+type keystoreKDFParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// ExportAccountKeystore encrypts a's private key under passphrase and
+// returns an Ethereum-style keystore JSON v3 document, as implemented by
+// go-ethereum: scrypt to derive a key, AES-128-CTR to encrypt, and a
+// keccak256 MAC over the second half of the derived key and the
+// ciphertext.
+func ExportAccountKeystore(a *Account, passphrase []byte, hrp string) ([]byte, error) {
+	if len(a.Private) == 0 {
+		return nil, fmt.Errorf("account %s has no private key to export (Ledger-backed accounts can't be exported)", a.DisplayName)
+	}
+
+	salt := make([]byte, keystoreScryptSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generating salt: %w", err)
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("generating iv: %w", err)
+	}
+
+	derivedKey, err := scrypt.Key(passphrase, salt, keystoreScryptN, keystoreScryptR, keystoreScryptP, keystoreScryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+	defer zero.Bytes(derivedKey)
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	ciphertext := make([]byte, len(a.Private))
+	cipher.NewCTR(block, iv).XORKeyStream(ciphertext, a.Private)
+
+	mac := keccak256(derivedKey[16:32], ciphertext)
+
+	var path string
+	if len(a.Path) > 0 {
+		path = a.Path.String()
+	}
+
+	ks := keystoreV3{
+		Version: 3,
+		Address: string(PubkeyToAddress(a.Public, hrp)),
+		Curve:   "ed25519",
+		Path:    path,
+		Crypto: keystoreV3Crypto{
+			Cipher:       "aes-128-ctr",
+			CipherParams: keystoreCipherParams{IV: hex.EncodeToString(iv)},
+			CipherText:   hex.EncodeToString(ciphertext),
+			KDF:          "scrypt",
+			KDFParams: keystoreKDFParams{
+				N:     keystoreScryptN,
+				R:     keystoreScryptR,
+				P:     keystoreScryptP,
+				DKLen: keystoreScryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+	}
+
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// ImportAccountKeystore decrypts a keystore JSON v3 document produced by
+// ExportAccountKeystore and returns the account it contains. If the
+// keystore carries a derivation path, it's preserved on the returned
+// Account; otherwise the account is labeled "imported".
+func ImportAccountKeystore(data []byte, passphrase []byte) (*Account, error) {
+	var ks keystoreV3
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, fmt.Errorf("parsing keystore: %w", err)
+	}
+	if ks.Version != 3 {
+		return nil, fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Curve != "ed25519" {
+		return nil, fmt.Errorf("unsupported keystore curve %q", ks.Curve)
+	}
+	if ks.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported cipher %q", ks.Crypto.Cipher)
+	}
+	if ks.Crypto.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported KDF %q", ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("decoding salt: %w", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("decoding iv: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ciphertext: %w", err)
+	}
+	wantMAC, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("decoding mac: %w", err)
+	}
+
+	p := ks.Crypto.KDFParams
+	derivedKey, err := scrypt.Key(passphrase, salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+	defer zero.Bytes(derivedKey)
+
+	if len(derivedKey) < 32 {
+		return nil, fmt.Errorf("keystore dklen %d too short", p.DKLen)
+	}
+	gotMAC := keccak256(derivedKey[16:32], ciphertext)
+	if subtle.ConstantTimeCompare(gotMAC, wantMAC) != 1 {
+		return nil, fmt.Errorf("incorrect passphrase (mac mismatch)")
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	if len(ciphertext) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("keystore ciphertext decodes to a %d-byte key, want %d", len(ciphertext), ed25519.PrivateKeySize)
+	}
+	priv := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(priv, ciphertext)
+
+	pub := []byte(ed25519.PrivateKey(priv).Public().(ed25519.PublicKey))
+
+	a := &Account{
+		DisplayName: "imported",
+		Created:     time.Now(),
+		Public:      pub,
+		Private:     priv,
+	}
+	if ks.Path != "" {
+		path, err := ParseDerivationPath(ks.Path)
+		if err != nil {
+			zero.Bytes(priv)
+			return nil, err
+		}
+		a.Path = path
+		a.DisplayName = fmt.Sprintf("Account %s (imported)", path.String())
+	}
+	return a, nil
+}
+
+// keccak256 returns the keccak256 digest of the concatenation of parts,
+// matching go-ethereum's keystore MAC construction.
+func keccak256(parts ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
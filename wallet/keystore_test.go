@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestExportImportKeystoreRoundTrip(t *testing.T) {
+	w, err := NewMultiWalletRandomMnemonic(1)
+	if err != nil {
+		t.Fatalf("NewMultiWalletRandomMnemonic: %v", err)
+	}
+	defer w.Close()
+	a := w.Secrets.Accounts[0]
+
+	passphrase := []byte("hunter2")
+	data, err := ExportAccountKeystore(a, passphrase, "sm")
+	if err != nil {
+		t.Fatalf("ExportAccountKeystore: %v", err)
+	}
+
+	imported, err := ImportAccountKeystore(data, passphrase)
+	if err != nil {
+		t.Fatalf("ImportAccountKeystore: %v", err)
+	}
+	if !bytes.Equal(imported.Private, a.Private) {
+		t.Fatalf("imported private key doesn't match the exported one")
+	}
+	if !bytes.Equal(imported.Public, a.Public) {
+		t.Fatalf("imported public key doesn't match the exported one")
+	}
+	if imported.Path.String() != a.Path.String() {
+		t.Fatalf("imported path = %q, want %q", imported.Path.String(), a.Path.String())
+	}
+}
+
+func TestImportKeystoreWrongPassphrase(t *testing.T) {
+	w, err := NewMultiWalletRandomMnemonic(1)
+	if err != nil {
+		t.Fatalf("NewMultiWalletRandomMnemonic: %v", err)
+	}
+	defer w.Close()
+
+	data, err := ExportAccountKeystore(w.Secrets.Accounts[0], []byte("hunter2"), "sm")
+	if err != nil {
+		t.Fatalf("ExportAccountKeystore: %v", err)
+	}
+	if _, err := ImportAccountKeystore(data, []byte("wrong")); err == nil {
+		t.Fatalf("ImportAccountKeystore with the wrong passphrase should have failed")
+	}
+}
+
+func TestExportLedgerAccountRejected(t *testing.T) {
+	a := &Account{
+		DisplayName: "Account 0",
+		Created:     time.Now(),
+		Public:      make([]byte, 32),
+	}
+	if _, err := ExportAccountKeystore(a, []byte("hunter2"), "sm"); err == nil {
+		t.Fatalf("exporting a Ledger-backed account (no private key) should have failed")
+	}
+}
+
+func TestImportKeystoreBadCiphertextLength(t *testing.T) {
+	w, err := NewMultiWalletRandomMnemonic(1)
+	if err != nil {
+		t.Fatalf("NewMultiWalletRandomMnemonic: %v", err)
+	}
+	defer w.Close()
+
+	// Export an account whose private key is shorter than ed25519's, to
+	// produce a ciphertext the importer must reject rather than panic on.
+	short := &Account{
+		DisplayName: w.Secrets.Accounts[0].DisplayName,
+		Public:      w.Secrets.Accounts[0].Public,
+		Private:     w.Secrets.Accounts[0].Private[:16],
+	}
+	data, err := ExportAccountKeystore(short, []byte("hunter2"), "sm")
+	if err != nil {
+		t.Fatalf("ExportAccountKeystore: %v", err)
+	}
+	if _, err := ImportAccountKeystore(data, []byte("hunter2")); err == nil {
+		t.Fatalf("importing an undersized key should have failed, not panicked")
+	}
+}
@@ -0,0 +1,13 @@
+package wallet
+
+import "fmt"
+
+// ledgerGetPublicKey is the hook for reading the ed25519 public key of the
+// account at the given derivation path from a Ledger device. This package
+// has no transport to a Ledger device, so it always returns an error; it
+// never returns private key material.
+//
+// TODO: wire up the actual USB/HID transport to the Spacemesh Ledger app.
+func ledgerGetPublicKey(path DerivationPath) ([]byte, error) {
+	return nil, fmt.Errorf("ledger support not yet implemented (path %s)", path)
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SelectAccount resolves selector to a single account in accounts. selector
+// may be:
+//   - empty, which selects accounts[0]
+//   - a decimal index into accounts, e.g. "0"
+//   - a suffix of the account's BIP-32 derivation path, e.g. "0'/0'"
+//   - the account's derived address under hrp, e.g. "sm1qqq..."
+//
+// It returns the matching account together with its index in accounts.
+func SelectAccount(accounts []*Account, selector, hrp string) (*Account, int, error) {
+	if len(accounts) == 0 {
+		return nil, 0, fmt.Errorf("wallet has no accounts")
+	}
+	if selector == "" {
+		return accounts[0], 0, nil
+	}
+	if idx, err := strconv.Atoi(selector); err == nil {
+		if idx < 0 || idx >= len(accounts) {
+			return nil, 0, fmt.Errorf("account index %d out of range (wallet has %d accounts)", idx, len(accounts))
+		}
+		return accounts[idx], idx, nil
+	}
+	for i, a := range accounts {
+		if pathHasSuffix(a.Path, selector) {
+			return a, i, nil
+		}
+	}
+	for i, a := range accounts {
+		if string(PubkeyToAddress(a.Public, hrp)) == selector {
+			return a, i, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("no account matches %q (not an index, derivation path suffix, or address)", selector)
+}
+
+// pathHasSuffix reports whether path's final segments, joined with "/",
+// equal selector, e.g. path "44'/540'/1'/0'" matches selector "1'/0'".
+func pathHasSuffix(path DerivationPath, selector string) bool {
+	pathSegs := strings.Split(path.String(), "/")
+	selSegs := strings.Split(selector, "/")
+	if len(selSegs) > len(pathSegs) {
+		return false
+	}
+	tail := pathSegs[len(pathSegs)-len(selSegs):]
+	return strings.Join(tail, "/") == selector
+}
@@ -0,0 +1,102 @@
+package wallet
+
+import "testing"
+
+func newTestAccounts(n int) []*Account {
+	accounts := make([]*Account, n)
+	for i := range accounts {
+		pub := make([]byte, 32)
+		pub[31] = byte(i)
+		accounts[i] = &Account{
+			DisplayName: "Account",
+			Path:        DerivationPath{44 | hardenedBit, 540 | hardenedBit, uint32(i) | hardenedBit, 0 | hardenedBit},
+			Public:      pub,
+		}
+	}
+	return accounts
+}
+
+func TestSelectAccountEmptySelectsFirst(t *testing.T) {
+	accounts := newTestAccounts(3)
+	a, idx, err := SelectAccount(accounts, "", "sm")
+	if err != nil {
+		t.Fatalf("SelectAccount: %v", err)
+	}
+	if idx != 0 || a != accounts[0] {
+		t.Fatalf("got account %d, want 0", idx)
+	}
+}
+
+func TestSelectAccountByIndex(t *testing.T) {
+	accounts := newTestAccounts(3)
+	a, idx, err := SelectAccount(accounts, "2", "sm")
+	if err != nil {
+		t.Fatalf("SelectAccount: %v", err)
+	}
+	if idx != 2 || a != accounts[2] {
+		t.Fatalf("got account %d, want 2", idx)
+	}
+}
+
+func TestSelectAccountIndexOutOfRange(t *testing.T) {
+	accounts := newTestAccounts(3)
+	if _, _, err := SelectAccount(accounts, "3", "sm"); err == nil {
+		t.Fatalf("SelectAccount with an out-of-range index should have failed")
+	}
+}
+
+func TestSelectAccountByPathSuffix(t *testing.T) {
+	accounts := newTestAccounts(3)
+	a, idx, err := SelectAccount(accounts, "1'/0'", "sm")
+	if err != nil {
+		t.Fatalf("SelectAccount: %v", err)
+	}
+	if idx != 1 || a != accounts[1] {
+		t.Fatalf("got account %d, want 1", idx)
+	}
+}
+
+func TestSelectAccountByAddress(t *testing.T) {
+	accounts := newTestAccounts(3)
+	address := string(PubkeyToAddress(accounts[1].Public, "sm"))
+	a, idx, err := SelectAccount(accounts, address, "sm")
+	if err != nil {
+		t.Fatalf("SelectAccount: %v", err)
+	}
+	if idx != 1 || a != accounts[1] {
+		t.Fatalf("got account %d, want 1", idx)
+	}
+}
+
+func TestSelectAccountNoMatch(t *testing.T) {
+	accounts := newTestAccounts(3)
+	if _, _, err := SelectAccount(accounts, "nonsense", "sm"); err == nil {
+		t.Fatalf("SelectAccount with no matching selector should have failed")
+	}
+}
+
+func TestSelectAccountEmptyWallet(t *testing.T) {
+	if _, _, err := SelectAccount(nil, "0", "sm"); err == nil {
+		t.Fatalf("SelectAccount on a wallet with no accounts should have failed")
+	}
+}
+
+func TestPathHasSuffix(t *testing.T) {
+	path := DerivationPath{44 | hardenedBit, 540 | hardenedBit, 1 | hardenedBit, 0 | hardenedBit}
+	cases := []struct {
+		selector string
+		want     bool
+	}{
+		{"0'", true},
+		{"1'/0'", true},
+		{"44'/540'/1'/0'", true},
+		{"540'/1'/0'", true},
+		{"2'/0'", false},
+		{"44'/540'/1'/0'/0'", false},
+	}
+	for _, c := range cases {
+		if got := pathHasSuffix(path, c.selector); got != c.want {
+			t.Errorf("pathHasSuffix(%q, %q) = %v, want %v", path.String(), c.selector, got, c.want)
+		}
+	}
+}
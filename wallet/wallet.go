@@ -0,0 +1,266 @@
+// Package wallet implements smcli's on-disk wallet format: BIP-39 mnemonic
+// generation, SLIP-0010 ed25519 child key derivation, and encrypted
+// storage of the resulting keypairs.
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"github.com/tyler-smith/go-bip39"
+
+	"github.com/spacemeshos/smcli/internal/zero"
+)
+
+// Secrets holds every piece of key material associated with a wallet.
+type Secrets struct {
+	// Mnemonic is the BIP-39 mnemonic the wallet was generated from. It is
+	// empty for wallets backed by a Ledger device or imported from a
+	// keystore file without a recoverable mnemonic.
+	Mnemonic string `json:"mnemonic,omitempty"`
+
+	// MasterKeypair is the root keypair the mnemonic derives. It is nil
+	// for Ledger-backed wallets.
+	MasterKeypair *Account `json:"masterKeypair,omitempty"`
+
+	// Accounts are the wallet's child accounts, in derivation order.
+	Accounts []*Account `json:"accounts"`
+}
+
+// Wallet is a full, in-memory wallet: the decrypted secrets read from or
+// written to the wallet file.
+type Wallet struct {
+	Secrets Secrets `json:"secrets"`
+}
+
+// Mnemonic returns the wallet's BIP-39 mnemonic, or the empty string if
+// the wallet has none (Ledger-backed or imported without one).
+func (w *Wallet) Mnemonic() string {
+	return w.Secrets.Mnemonic
+}
+
+// Close zeros the master keypair's private half and each account's
+// private half in place, and drops the wallet's reference to the mnemonic
+// (a string, which zero.String can't overwrite in place). It should be
+// called as soon as a wallet is no longer needed, typically via defer
+// right after it's opened.
+//
+// Close leaves the wallet's public data (addresses, paths, display names)
+// intact; it's the private halves and mnemonic that must not outlive the
+// command that needed them.
+func (w *Wallet) Close() {
+	w.Lock()
+}
+
+// Lock zeros the wallet's private key material in place. It is equivalent
+// to Close and exists to mirror the Lock/Unlock terminology used by the
+// wallet daemon.
+func (w *Wallet) Lock() {
+	zero.String(&w.Secrets.Mnemonic)
+	if m := w.Secrets.MasterKeypair; m != nil {
+		zero.Bytes(m.Private)
+	}
+	for _, a := range w.Secrets.Accounts {
+		if a != nil {
+			zero.Bytes(a.Private)
+		}
+	}
+}
+
+// deriveAccounts derives n child accounts (and the master keypair) from
+// the given BIP-39 seed.
+func deriveAccounts(seed []byte, n int) (*Account, []*Account, error) {
+	master, err := deriveMaster(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts := make([]*Account, n)
+	for i := 0; i < n; i++ {
+		path := DerivationPath{44 | hardenedBit, 540 | hardenedBit, uint32(i) | hardenedBit, 0 | hardenedBit}
+		priv, pub, err := deriveChild(seed, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		accounts[i] = &Account{
+			Path:        path,
+			DisplayName: fmt.Sprintf("Account %d", i),
+			Created:     time.Now(),
+			Public:      pub,
+			Private:     priv,
+		}
+	}
+	return master, accounts, nil
+}
+
+// NewMultiWalletRandomMnemonic generates a fresh, random BIP-39 mnemonic
+// and derives n child accounts from it.
+func NewMultiWalletRandomMnemonic(n int) (*Wallet, error) {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return nil, err
+	}
+	defer zero.Bytes(entropy)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return nil, err
+	}
+	defer zero.String(&mnemonic)
+	return NewMultiWalletFromMnemonic(mnemonic, n)
+}
+
+// NewMultiWalletFromMnemonic derives n child accounts from an existing
+// BIP-39 mnemonic.
+func NewMultiWalletFromMnemonic(mnemonic string, n int) (*Wallet, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid mnemonic")
+	}
+	seed := bip39.NewSeed(mnemonic, "")
+	defer zero.Bytes(seed)
+	master, accounts, err := deriveAccounts(seed, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		Secrets: Secrets{
+			// strings.Clone, not a plain assignment: a plain assignment
+			// would leave w.Secrets.Mnemonic sharing the caller's backing
+			// array, so dropping the caller's reference via zero.String
+			// (or Close() zeroing w.Secrets.Mnemonic later) wouldn't be
+			// the only live reference to those bytes.
+			Mnemonic:      strings.Clone(mnemonic),
+			MasterKeypair: master,
+			Accounts:      accounts,
+		},
+	}, nil
+}
+
+// NewMultiWalletFromLedger builds a Ledger-backed wallet from n accounts'
+// public keys, read via ledgerGetPublicKey. Since ledgerGetPublicKey always
+// returns an error, so does this function for any n > 0. No private key
+// material or mnemonic is ever held in memory for a Ledger-backed wallet.
+func NewMultiWalletFromLedger(n int) (*Wallet, error) {
+	accounts := make([]*Account, n)
+	for i := 0; i < n; i++ {
+		path := DerivationPath{44 | hardenedBit, 540 | hardenedBit, uint32(i) | hardenedBit, 0 | hardenedBit}
+		pub, err := ledgerGetPublicKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading account %d from ledger: %w", i, err)
+		}
+		accounts[i] = &Account{
+			Path:        path,
+			DisplayName: fmt.Sprintf("Account %d", i),
+			Created:     time.Now(),
+			Public:      pub,
+		}
+	}
+	return &Wallet{Secrets: Secrets{Accounts: accounts}}, nil
+}
+
+// Address is a Spacemesh bech32-encoded account address, derived from an
+// ed25519 public key.
+type Address string
+
+// PubkeyToAddress derives the Spacemesh address for a public key under the
+// given human-readable prefix: the low 20 bytes of the public key's SHA-256
+// hash, bech32-encoded (BIP-173) under hrp.
+func PubkeyToAddress(pub []byte, hrp string) Address {
+	sum := sha256.Sum256(pub)
+	data, err := bech32.ConvertBits(sum[12:], 8, 5, true)
+	if err != nil {
+		// sum[12:] is always exactly 20 bytes, so regrouping into 5-bit
+		// words can't fail.
+		panic(fmt.Sprintf("converting address bits: %v", err))
+	}
+	encoded, err := bech32.Encode(hrp, data)
+	if err != nil {
+		panic(fmt.Sprintf("bech32-encoding address: %v", err))
+	}
+	return Address(encoded)
+}
+
+// slip10Ed25519SeedKey is the fixed HMAC key SLIP-0010 uses to derive an
+// ed25519 master key from a BIP-39 seed. See
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md.
+const slip10Ed25519SeedKey = "ed25519 seed"
+
+// extendedKey is a SLIP-0010 ed25519 key paired with the chain code needed
+// to derive its children.
+type extendedKey struct {
+	key       []byte // 32-byte ed25519 seed
+	chainCode []byte // 32 bytes
+}
+
+// slip10Master derives the master extended key for a BIP-39 seed.
+func slip10Master(seed []byte) extendedKey {
+	mac := hmac.New(sha512.New, []byte(slip10Ed25519SeedKey))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	defer zero.Bytes(sum)
+	return extendedKey{
+		key:       append([]byte(nil), sum[:32]...),
+		chainCode: append([]byte(nil), sum[32:]...),
+	}
+}
+
+// deriveHardenedChild derives the hardened child of parent at idx. SLIP-0010
+// only defines hardened derivation for ed25519, so idx must have the
+// hardened bit set.
+func deriveHardenedChild(parent extendedKey, idx uint32) (extendedKey, error) {
+	if idx&hardenedBit == 0 {
+		return extendedKey{}, fmt.Errorf("slip-0010 ed25519 only supports hardened derivation, got non-hardened index %d", idx)
+	}
+	data := make([]byte, 1+32+4)
+	defer zero.Bytes(data)
+	data[0] = 0x00
+	copy(data[1:33], parent.key)
+	binary.BigEndian.PutUint32(data[33:37], idx)
+
+	mac := hmac.New(sha512.New, parent.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	defer zero.Bytes(sum)
+	return extendedKey{
+		key:       append([]byte(nil), sum[:32]...),
+		chainCode: append([]byte(nil), sum[32:]...),
+	}, nil
+}
+
+// deriveMaster derives the master keypair from a BIP-39 seed.
+func deriveMaster(seed []byte) (*Account, error) {
+	mk := slip10Master(seed)
+	defer zero.Bytes(mk.key)
+	defer zero.Bytes(mk.chainCode)
+	priv := ed25519.NewKeyFromSeed(mk.key)
+	return &Account{
+		DisplayName: "Master Key",
+		Created:     time.Now(),
+		Public:      []byte(priv.Public().(ed25519.PublicKey)),
+		Private:     []byte(priv),
+	}, nil
+}
+
+// deriveChild derives a single ed25519 keypair at the given path using
+// SLIP-0010 hardened-only ed25519 derivation.
+func deriveChild(seed []byte, path DerivationPath) (priv, pub []byte, err error) {
+	cur := slip10Master(seed)
+	for _, idx := range path {
+		next, err := deriveHardenedChild(cur, idx)
+		zero.Bytes(cur.key)
+		zero.Bytes(cur.chainCode)
+		if err != nil {
+			return nil, nil, err
+		}
+		cur = next
+	}
+	defer zero.Bytes(cur.key)
+	defer zero.Bytes(cur.chainCode)
+	key := ed25519.NewKeyFromSeed(cur.key)
+	return []byte(key), []byte(key.Public().(ed25519.PublicKey)), nil
+}